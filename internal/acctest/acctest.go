@@ -137,6 +137,18 @@ type ProviderFunc func() *schema.Provider
 // Provider be errantly reused in ProviderFactories.
 var testAccProviderConfigure sync.Once
 
+// AlternateAccountProvider is a provider instance configured with the alternate account credentials.
+//
+// It can be used in PreCheck functions, before any Terraform configuration is applied,
+// to verify the alternate account's configuration, e.g. AWS Organizations membership.
+//
+// PreCheckAlternateAccountProvider(ctx, t) must be called before using this provider instance.
+var (
+	AlternateAccountProvider *schema.Provider
+
+	alternateAccountProviderConfigure sync.Once
+)
+
 func protoV5ProviderFactoriesInit(ctx context.Context, providerNames ...string) map[string]func() (tfprotov5.ProviderServer, error) {
 	factories := make(map[string]func() (tfprotov5.ProviderServer, error), len(providerNames))
 
@@ -247,6 +259,24 @@ func ProtoV5FactoriesAlternateAccountAndAlternateRegion(ctx context.Context, t *
 	)
 }
 
+// ProtoV5FactoriesMultipleAccounts creates ProtoV5ProviderFactories for the specified number of account configurations
+//
+// Usage typically paired with PreCheckMultipleAccount and ConfigMultipleAccountProvider.
+func ProtoV5FactoriesMultipleAccounts(ctx context.Context, t *testing.T, n int) map[string]func() (tfprotov5.ProviderServer, error) {
+	t.Helper()
+
+	switch n {
+	case 2:
+		return protoV5ProviderFactoriesInit(ctx, ProviderName, ProviderNameAlternate)
+	case 3:
+		return protoV5ProviderFactoriesInit(ctx, ProviderName, ProviderNameAlternate, ProviderNameThird)
+	default:
+		t.Fatalf("invalid number of Account configurations: %d", n)
+	}
+
+	return nil
+}
+
 // ProtoV5FactoriesMultipleRegions creates ProtoV5ProviderFactories for the specified number of region configurations
 //
 // Usage typically paired with PreCheckMultipleRegion and ConfigMultipleRegionProvider.
@@ -934,6 +964,65 @@ func PreCheckThirdAccount(t *testing.T) {
 	}
 }
 
+// PreCheckMultipleAccount verifies that credentials for the specified number of accounts are configured.
+//
+// Usage typically paired with ProtoV5FactoriesMultipleAccounts and ConfigMultipleAccountProvider.
+func PreCheckMultipleAccount(t *testing.T, accounts int) {
+	t.Helper()
+
+	switch accounts {
+	case 2:
+		PreCheckAlternateAccount(t)
+	case 3:
+		PreCheckAlternateAccount(t)
+		PreCheckThirdAccount(t)
+	default:
+		t.Fatalf("invalid number of Account configurations: %d", accounts)
+	}
+}
+
+// PreCheckAlternateAccountProvider verifies that alternate account credentials are configured and
+// creates and configures AlternateAccountProvider.
+func PreCheckAlternateAccountProvider(ctx context.Context, t *testing.T) {
+	t.Helper()
+
+	PreCheck(ctx, t)
+	PreCheckAlternateAccount(t)
+
+	alternateAccountProviderConfigure.Do(func() {
+		p, err := provider.New(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		configureNamedAccountProvider(ctx, t, p, os.Getenv(envvar.AlternateAccessKeyId), os.Getenv(envvar.AlternateProfile), os.Getenv(envvar.AlternateSecretAccessKey))
+
+		AlternateAccountProvider = p
+	})
+
+	if AlternateAccountProvider == nil {
+		t.Fatal("alternate account provider not configured")
+	}
+}
+
+func configureNamedAccountProvider(ctx context.Context, t *testing.T, p *schema.Provider, accessKey, profile, secretKey string) {
+	t.Helper()
+
+	raw := map[string]interface{}{}
+	if accessKey != "" {
+		raw["access_key"] = accessKey
+		raw["secret_key"] = secretKey
+	}
+	if profile != "" {
+		raw["profile"] = profile
+	}
+
+	diags := p.Configure(ctx, terraformsdk.NewResourceConfigRaw(raw))
+	if err := sdkdiag.DiagnosticsError(diags); err != nil {
+		t.Fatalf("configuring provider: %s", err)
+	}
+}
+
 func PreCheckPartitionHasService(t *testing.T, serviceID string) {
 	t.Helper()
 
@@ -1141,12 +1230,28 @@ func PreCheckOrganizationManagementAccountWithProvider(ctx context.Context, t *t
 	}
 }
 
+// PreCheckAlternateAccountOrganizationManagementAccount verifies that the alternate account is the management account of an AWS Organization.
+func PreCheckAlternateAccountOrganizationManagementAccount(ctx context.Context, t *testing.T) {
+	t.Helper()
+
+	PreCheckAlternateAccountProvider(ctx, t)
+	PreCheckOrganizationManagementAccountWithProvider(ctx, t, func() *schema.Provider { return AlternateAccountProvider })
+}
+
 func PreCheckOrganizationMemberAccount(ctx context.Context, t *testing.T) {
 	t.Helper()
 
 	PreCheckOrganizationMemberAccountWithProvider(ctx, t, func() *schema.Provider { return Provider })
 }
 
+// PreCheckAlternateAccountOrganizationMemberAccount verifies that the alternate account is a member (not the management) account of an AWS Organization.
+func PreCheckAlternateAccountOrganizationMemberAccount(ctx context.Context, t *testing.T) {
+	t.Helper()
+
+	PreCheckAlternateAccountProvider(ctx, t)
+	PreCheckOrganizationMemberAccountWithProvider(ctx, t, func() *schema.Provider { return AlternateAccountProvider })
+}
+
 func PreCheckOrganizationMemberAccountWithProvider(ctx context.Context, t *testing.T, providerF ProviderFunc) {
 	t.Helper()
 
@@ -40,10 +40,12 @@ type AWSClient struct {
 	httpClient                *http.Client
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
+	operationSemaphores       map[string]chan struct{} // From provider configuration.
 	session                   *session_sdkv1.Session
 	s3ExpressClient           *s3_sdkv2.Client
 	s3UsePathStyle            bool   // From provider configuration.
 	s3USEast1RegionalEndpoint string // From provider configuration.
+	skipUnsetSubresourceReads bool   // From provider configuration.
 	stsRegion                 string // From provider configuration.
 }
 
@@ -100,6 +102,35 @@ func (c *AWSClient) S3UsePathStyle(context.Context) bool {
 	return c.s3UsePathStyle
 }
 
+// SkipUnsetSubresourceReads returns the skip_unset_subresource_reads provider configuration value.
+func (c *AWSClient) SkipUnsetSubresourceReads(context.Context) bool {
+	return c.skipUnsetSubresourceReads
+}
+
+// AcquireServiceOperation blocks until a create, update or delete operation against the specified
+// service package may proceed under the max_concurrent_operations provider configuration.
+// Each successful call must be paired with a call to ReleaseServiceOperation.
+func (c *AWSClient) AcquireServiceOperation(ctx context.Context, servicePackageName string) error {
+	semaphore, ok := c.operationSemaphores[servicePackageName]
+	if !ok {
+		return nil
+	}
+
+	select {
+	case semaphore <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// ReleaseServiceOperation releases an operation slot obtained via AcquireServiceOperation.
+func (c *AWSClient) ReleaseServiceOperation(_ context.Context, servicePackageName string) {
+	if semaphore, ok := c.operationSemaphores[servicePackageName]; ok {
+		<-semaphore
+	}
+}
+
 // SetHTTPClient sets the http.Client used for AWS API calls.
 // To have effect it must be called before the AWS SDK v1 Session is created.
 func (c *AWSClient) SetHTTPClient(_ context.Context, httpClient *http.Client) {
@@ -5,6 +5,7 @@ package conns
 
 import (
 	"context"
+	"errors"
 	"testing"
 )
 
@@ -177,3 +178,39 @@ func TestAWSClientEC2PublicDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-f
 		})
 	}
 }
+
+func TestAWSClientServiceOperations(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.TODO()
+	client := &AWSClient{
+		operationSemaphores: map[string]chan struct{}{
+			"cloudfront": make(chan struct{}, 1),
+		},
+	}
+
+	// Services without a limit never block.
+	for range 3 {
+		if err := client.AcquireServiceOperation(ctx, "iam"); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if err := client.AcquireServiceOperation(ctx, "cloudfront"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The limit has been reached, so a second acquisition blocks until the context is done.
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	if err := client.AcquireServiceOperation(cancelledCtx, "cloudfront"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+
+	client.ReleaseServiceOperation(ctx, "cloudfront")
+
+	if err := client.AcquireServiceOperation(ctx, "cloudfront"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	client.ReleaseServiceOperation(ctx, "cloudfront")
+}
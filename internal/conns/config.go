@@ -42,7 +42,9 @@ type Config struct {
 	HTTPSProxy                     *string
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
+	MaxConcurrentOperations        map[string]int
 	MaxRetries                     int
+	MaxRetryDelay                  time.Duration
 	NoProxy                        string
 	Profile                        string
 	Region                         string
@@ -55,6 +57,7 @@ type Config struct {
 	SkipCredsValidation            bool
 	SkipRegionValidation           bool
 	SkipRequestingAccountId        bool
+	SkipUnsetSubresourceReads      bool
 	STSRegion                      string
 	SuppressDebugLog               bool
 	TerraformVersion               string
@@ -71,8 +74,12 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	ctx, logger := logging.NewTfLogger(ctx)
 
 	const (
-		maxBackoff = 300 * time.Second // AWS SDK for Go v1 DefaultRetryerMaxRetryDelay: https://github.com/aws/aws-sdk-go/blob/9f6e3bb9f523aef97fa1cd5c5f8ba8ecf212e44e/aws/client/default_retryer.go#L48-L49.
+		defaultMaxBackoff = 300 * time.Second // AWS SDK for Go v1 DefaultRetryerMaxRetryDelay: https://github.com/aws/aws-sdk-go/blob/9f6e3bb9f523aef97fa1cd5c5f8ba8ecf212e44e/aws/client/default_retryer.go#L48-L49.
 	)
+	maxBackoff := defaultMaxBackoff
+	if c.MaxRetryDelay > 0 {
+		maxBackoff = c.MaxRetryDelay
+	}
 	awsbaseConfig := awsbase.Config{
 		AccessKey:         c.AccessKey,
 		AllowedAccountIds: c.AllowedAccountIds,
@@ -221,8 +228,13 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.conns = make(map[string]any, 0)
 	client.endpoints = c.Endpoints
 	client.logger = logger
+	client.operationSemaphores = make(map[string]chan struct{}, len(c.MaxConcurrentOperations))
+	for servicePackageName, limit := range c.MaxConcurrentOperations {
+		client.operationSemaphores[servicePackageName] = make(chan struct{}, limit)
+	}
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
+	client.skipUnsetSubresourceReads = c.SkipUnsetSubresourceReads
 	client.stsRegion = c.STSRegion
 
 	return client, diags
@@ -653,3 +653,47 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 func (r tagsResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	return ctx, diags
 }
+
+// serviceOperationsResourceInterceptor limits the number of concurrent create, update and delete operations per service package.
+// It must be the last interceptor so that a short-circuiting Before interceptor cannot leak an acquired operation slot.
+type serviceOperationsResourceInterceptor struct{}
+
+func (r serviceOperationsResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, when, diags)
+}
+
+func (r serviceOperationsResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r serviceOperationsResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, when, diags)
+}
+
+func (r serviceOperationsResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, when, diags)
+}
+
+func (r serviceOperationsResourceInterceptor) run(ctx context.Context, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if meta == nil {
+		return ctx, diags
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		if err := meta.AcquireServiceOperation(ctx, inContext.ServicePackageName); err != nil {
+			diags.AddError(fmt.Sprintf("waiting for %s operation slot", inContext.ServicePackageName), err.Error())
+
+			return ctx, diags
+		}
+	case Finally:
+		meta.ReleaseServiceOperation(ctx, inContext.ServicePackageName)
+	}
+
+	return ctx, diags
+}
@@ -85,10 +85,19 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, default value is `false`",
 			},
+			"max_concurrent_operations": schema.MapAttribute{
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Description: "Maximum number of concurrent create, update and delete operations per AWS service, keyed by service name (e.g. `cloudfront` or `iam`). Independent of Terraform's `-parallelism`.",
+			},
 			"max_retries": schema.Int64Attribute{
 				Optional:    true,
 				Description: "The maximum number of times an AWS API request is\nbeing executed. If the API request still fails, an error is\nthrown.",
 			},
+			"max_retry_delay": schema.StringAttribute{
+				Optional:    true,
+				Description: "The maximum delay between retries of an AWS API request, as a duration\nstring such as `30s` or `2m`. Defaults to `300s`.",
+			},
 			"no_proxy": schema.StringAttribute{
 				Optional:    true,
 				Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
@@ -145,6 +154,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Skip requesting the account ID. Used for AWS API implementations that do not have IAM/STS API and/or metadata API.",
 			},
+			"skip_unset_subresource_reads": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip reading expensive sub-resource configurations that are unset in state during refresh. Reduces API calls for resources such as aws_s3_bucket and aws_iam_role at the cost of drift detection for those sub-resources.",
+			},
 			"sts_region": schema.StringAttribute{
 				Optional:    true,
 				Description: "The region where AWS STS operations will take place. Examples\nare us-east-1 and us-west-2.", // lintignore:AWSAT003
@@ -442,6 +455,9 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				interceptors = append(interceptors, tagsResourceInterceptor{tags: v.Tags})
 			}
 
+			// Must be last.
+			interceptors = append(interceptors, serviceOperationsResourceInterceptor{})
+
 			resources = append(resources, func() resource.Resource {
 				return newWrappedResource(bootstrapContext, inner, interceptors)
 			})
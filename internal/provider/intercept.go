@@ -384,6 +384,33 @@ func (r tagsResourceInterceptor) run(ctx context.Context, d schemaResourceData,
 	return ctx, diags
 }
 
+// serviceOperationsInterceptor limits the number of concurrent create, update and delete operations per service package.
+// It must be the last interceptor so that a short-circuiting Before interceptor cannot leak an acquired operation slot.
+func serviceOperationsInterceptor() interceptorFunc {
+	return func(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+		c, ok := meta.(*conns.AWSClient)
+		if !ok {
+			return ctx, diags
+		}
+
+		inContext, ok := conns.FromContext(ctx)
+		if !ok {
+			return ctx, diags
+		}
+
+		switch when {
+		case Before:
+			if err := c.AcquireServiceOperation(ctx, inContext.ServicePackageName); err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "waiting for %s operation slot: %s", inContext.ServicePackageName, err)
+			}
+		case Finally:
+			c.ReleaseServiceOperation(ctx, inContext.ServicePackageName)
+		}
+
+		return ctx, diags
+	}
+}
+
 // tagsResourceInterceptor implements transparent tagging for data sources.
 type tagsDataSourceInterceptor struct {
 	tags *types.ServicePackageResourceTags
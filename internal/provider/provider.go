@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -136,6 +137,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, " +
 					"default value is `false`",
 			},
+			"max_concurrent_operations": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+				Description: "Maximum number of concurrent create, update and delete operations per AWS service, " +
+					"keyed by service name (e.g. `cloudfront` or `iam`). Independent of Terraform's `-parallelism`.",
+			},
 			"max_retries": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -143,6 +151,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					"being executed. If the API request still fails, an error is\n" +
 					"thrown.",
 			},
+			"max_retry_delay": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidDuration,
+				Description: "The maximum delay between retries of an AWS API request, as a duration\n" +
+					"string such as `30s` or `2m`. Defaults to `300s`.",
+			},
 			"no_proxy": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -225,6 +240,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Skip requesting the account ID. " +
 					"Used for AWS API implementations that do not have IAM/STS API and/or metadata API.",
 			},
+			"skip_unset_subresource_reads": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Skip reading expensive sub-resource configurations that are unset in state during refresh. " +
+					"Reduces API calls for resources such as aws_s3_bucket and aws_iam_role at the cost of drift detection for those sub-resources.",
+			},
 			"sts_region": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -411,6 +432,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				})
 			}
 
+			// Must be last.
+			interceptors = append(interceptors, interceptorItem{
+				when:        Before | Finally,
+				why:         Create | Update | Delete,
+				interceptor: serviceOperationsInterceptor(),
+			})
+
 			rs := &wrappedResource{
 				bootstrapContext: bootstrapContext,
 				interceptors:     interceptors,
@@ -491,6 +519,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		SkipCredsValidation:            d.Get("skip_credentials_validation").(bool),
 		SkipRegionValidation:           d.Get("skip_region_validation").(bool),
 		SkipRequestingAccountId:        d.Get("skip_requesting_account_id").(bool),
+		SkipUnsetSubresourceReads:      d.Get("skip_unset_subresource_reads").(bool),
 		STSRegion:                      d.Get("sts_region").(string),
 		TerraformVersion:               terraformVersion,
 		Token:                          d.Get("token").(string),
@@ -595,10 +624,27 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, nil)
 	}
 
+	if v, ok := d.GetOk("max_concurrent_operations"); ok && len(v.(map[string]any)) > 0 {
+		maxConcurrentOperations, dx := expandMaxConcurrentOperations(v.(map[string]any))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.MaxConcurrentOperations = maxConcurrentOperations
+	}
+
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("max_retry_delay"); ok {
+		maxRetryDelay, err := time.ParseDuration(v.(string))
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+		config.MaxRetryDelay = maxRetryDelay
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -939,6 +985,30 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 	return ignoreConfig
 }
 
+func expandMaxConcurrentOperations(tfMap map[string]any) (map[string]int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	path := cty.GetAttrPath("max_concurrent_operations")
+	servicePackageNames := names.ProviderPackages()
+	maxConcurrentOperations := make(map[string]int, len(tfMap))
+
+	for k, v := range tfMap {
+		if !slices.Contains(servicePackageNames, k) {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(path.IndexString(k), "Invalid service", fmt.Sprintf("%q is not a supported service name.", k)))
+			continue
+		}
+
+		limit := v.(int)
+		if limit < 1 {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(path.IndexString(k), "Invalid value", fmt.Sprintf("Maximum number of concurrent operations for %q must be at least 1, got %d.", k, limit)))
+			continue
+		}
+
+		maxConcurrentOperations[k] = limit
+	}
+
+	return maxConcurrentOperations, diags
+}
+
 func DeprecatedEnvVarDiag(envvar, replacement string) diag.Diagnostic {
 	return errs.NewWarningDiagnostic(
 		"Deprecated Environment Variable",
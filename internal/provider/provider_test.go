@@ -297,6 +297,59 @@ func TestExpandDefaultTags(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandMaxConcurrentOperations(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		tfMap         map[string]any
+		expected      map[string]int
+		expectedError bool
+	}{
+		"valid": {
+			tfMap: map[string]any{
+				names.CloudFront: 2,
+				names.IAM:        5,
+			},
+			expected: map[string]int{
+				names.CloudFront: 2,
+				names.IAM:        5,
+			},
+		},
+		"unknown service": {
+			tfMap: map[string]any{
+				"notaservice": 2,
+			},
+			expectedError: true,
+		},
+		"zero limit": {
+			tfMap: map[string]any{
+				names.IAM: 0,
+			},
+			expectedError: true,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := expandMaxConcurrentOperations(testcase.tfMap)
+
+			if got, want := diags.HasError(), testcase.expectedError; got != want {
+				t.Fatalf("expected error: %t, got diagnostics: %v", want, diags)
+			}
+
+			if testcase.expectedError {
+				return
+			}
+
+			if diff := cmp.Diff(got, testcase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 	ctx := context.Background()
 	testcases := map[string]struct {
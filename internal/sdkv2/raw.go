@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"github.com/hashicorp/go-cty/cty"
+)
+
+// HasNonNullRawValues returns true if any of the top-level attributes are set in the raw configuration or state value
+// (e.g. as returned by schema.ResourceData.GetRawConfig).
+// Null values and empty collections are not considered set. Unknown values are considered set.
+func HasNonNullRawValues(v cty.Value, keys ...string) bool {
+	if !v.IsKnown() || v.IsNull() || !v.Type().IsObjectType() {
+		return false
+	}
+
+	for _, key := range keys {
+		if !v.Type().HasAttribute(key) {
+			continue
+		}
+
+		attr := v.GetAttr(key)
+
+		if !attr.IsKnown() {
+			return true
+		}
+
+		if attr.IsNull() {
+			continue
+		}
+
+		if t := attr.Type(); (t.IsListType() || t.IsSetType() || t.IsMapType()) && attr.LengthInt() == 0 {
+			continue
+		}
+
+		return true
+	}
+
+	return false
+}
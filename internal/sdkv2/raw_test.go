@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestHasNonNullRawValues(t *testing.T) {
+	t.Parallel()
+
+	objectType := cty.Object(map[string]cty.Type{
+		"policy": cty.String,
+		"grant":  cty.Set(cty.String),
+	})
+
+	testCases := map[string]struct {
+		value    cty.Value
+		keys     []string
+		expected bool
+	}{
+		"null object": {
+			value: cty.NullVal(objectType),
+			keys:  []string{"policy"},
+		},
+		"unknown object": {
+			value: cty.UnknownVal(objectType),
+			keys:  []string{"policy"},
+		},
+		"null attribute": {
+			value: cty.ObjectVal(map[string]cty.Value{
+				"policy": cty.NullVal(cty.String),
+				"grant":  cty.NullVal(cty.Set(cty.String)),
+			}),
+			keys: []string{"policy", "grant"},
+		},
+		"empty collection": {
+			value: cty.ObjectVal(map[string]cty.Value{
+				"policy": cty.NullVal(cty.String),
+				"grant":  cty.SetValEmpty(cty.String),
+			}),
+			keys: []string{"grant"},
+		},
+		"unknown attribute": {
+			value: cty.ObjectVal(map[string]cty.Value{
+				"policy": cty.UnknownVal(cty.String),
+				"grant":  cty.NullVal(cty.Set(cty.String)),
+			}),
+			keys:     []string{"policy"},
+			expected: true,
+		},
+		"set attribute": {
+			value: cty.ObjectVal(map[string]cty.Value{
+				"policy": cty.StringVal("{}"),
+				"grant":  cty.NullVal(cty.Set(cty.String)),
+			}),
+			keys:     []string{"grant", "policy"},
+			expected: true,
+		},
+		"non-empty collection": {
+			value: cty.ObjectVal(map[string]cty.Value{
+				"policy": cty.NullVal(cty.String),
+				"grant":  cty.SetVal([]cty.Value{cty.StringVal("READ")}),
+			}),
+			keys:     []string{"grant"},
+			expected: true,
+		},
+		"missing attribute": {
+			value: cty.ObjectVal(map[string]cty.Value{
+				"policy": cty.StringVal("{}"),
+				"grant":  cty.NullVal(cty.Set(cty.String)),
+			}),
+			keys: []string{"website"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := HasNonNullRawValues(testCase.value, testCase.keys...), testCase.expected; got != want {
+				t.Errorf("HasNonNullRawValues() = %t, want %t", got, want)
+			}
+		})
+	}
+}
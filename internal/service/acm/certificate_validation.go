@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -29,6 +30,7 @@ func resourceCertificateValidation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceCertificateValidationCreate,
 		ReadWithoutTimeout:   resourceCertificateValidationRead,
+		UpdateWithoutTimeout: schema.NoopContext, // Allow externally_validated_domains and polling_interval updates.
 		DeleteWithoutTimeout: schema.NoopContext,
 
 		Timeouts: &schema.ResourceTimeout{
@@ -41,6 +43,16 @@ func resourceCertificateValidation() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"externally_validated_domains": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"polling_interval": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidDuration,
+			},
 			"validation_record_fqdns": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -75,6 +87,11 @@ func resourceCertificateValidationCreate(ctx context.Context, d *schema.Resource
 				return sdkdiag.AppendErrorf(diags, "validation_record_fqdns is not valid for %s validation", v)
 			}
 
+			// Domains already validated (e.g. via records managed outside of Terraform) need not be listed.
+			if domainValidation.ValidationStatus == types.DomainStatusSuccess {
+				continue
+			}
+
 			if v := domainValidation.ResourceRecord; v != nil {
 				if v := aws.ToString(v.Name); v != "" {
 					fqdns[strings.TrimSuffix(v, ".")] = domainValidation
@@ -86,6 +103,17 @@ func resourceCertificateValidationCreate(ctx context.Context, d *schema.Resource
 			delete(fqdns, strings.TrimSuffix(v.(string), "."))
 		}
 
+		// Domains whose validation records are managed outside of Terraform need not be listed.
+		if v, ok := d.GetOk("externally_validated_domains"); ok {
+			for _, domainName := range v.(*schema.Set).List() {
+				for fqdn, domainValidation := range fqdns {
+					if aws.ToString(domainValidation.DomainName) == domainName.(string) {
+						delete(fqdns, fqdn)
+					}
+				}
+			}
+		}
+
 		if len(fqdns) > 0 {
 			var errs []error
 
@@ -97,7 +125,16 @@ func resourceCertificateValidationCreate(ctx context.Context, d *schema.Resource
 		}
 	}
 
-	if _, err := waitCertificateIssued(ctx, conn, arn, d.Timeout(schema.TimeoutCreate)); err != nil {
+	var pollInterval time.Duration
+	if v, ok := d.GetOk("polling_interval"); ok {
+		pollInterval, err = time.ParseDuration(v.(string))
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	if _, err := waitCertificateIssued(ctx, conn, arn, d.Timeout(schema.TimeoutCreate), pollInterval); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for ACM Certificate (%s) to be issued: %s", arn, err)
 	}
 
@@ -167,12 +204,13 @@ func statusCertificate(ctx context.Context, conn *acm.Client, arn string) retry.
 	}
 }
 
-func waitCertificateIssued(ctx context.Context, conn *acm.Client, arn string, timeout time.Duration) (*types.CertificateDetail, error) {
+func waitCertificateIssued(ctx context.Context, conn *acm.Client, arn string, timeout, pollInterval time.Duration) (*types.CertificateDetail, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(types.CertificateStatusPendingValidation),
-		Target:  enum.Slice(types.CertificateStatusIssued),
-		Refresh: statusCertificate(ctx, conn, arn),
-		Timeout: timeout,
+		Pending:      enum.Slice(types.CertificateStatusPendingValidation),
+		Target:       enum.Slice(types.CertificateStatusIssued),
+		Refresh:      statusCertificate(ctx, conn, arn),
+		Timeout:      timeout,
+		PollInterval: pollInterval,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -183,6 +183,33 @@ func TestAccACMCertificateValidation_validationRecordFQDNSSan(t *testing.T) {
 	})
 }
 
+func TestAccACMCertificateValidation_externallyValidatedDomains(t *testing.T) {
+	ctx := acctest.Context(t)
+	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
+	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
+	sanDomain := acctest.ACMCertificateRandomSubDomain(rootDomain)
+	certificateResourceName := "aws_acm_certificate.test"
+	resourceName := "aws_acm_certificate_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ACMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCertificateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateValidationConfig_externallyValidatedDomains(rootDomain, domain, sanDomain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCertificateValidationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrCertificateARN, certificateResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "externally_validated_domains.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "polling_interval", "5s"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccACMCertificateValidation_validationRecordFQDNSWildcard(t *testing.T) {
 	ctx := acctest.Context(t)
 	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
@@ -448,6 +475,49 @@ resource "aws_acm_certificate_validation" "test" {
 `, domainName, subjectAlternativeNames, rootZoneDomain)
 }
 
+func testAccCertificateValidationConfig_externallyValidatedDomains(rootZoneDomain, domainName, subjectAlternativeName string) string {
+	return fmt.Sprintf(`
+resource "aws_acm_certificate" "test" {
+  domain_name               = %[1]q
+  subject_alternative_names = [%[2]q]
+  validation_method         = "DNS"
+}
+
+data "aws_route53_zone" "test" {
+  name         = %[3]q
+  private_zone = false
+}
+
+resource "aws_route53_record" "test" {
+  allow_overwrite = true
+  name            = tolist(aws_acm_certificate.test.domain_validation_options)[0].resource_record_name
+  records         = [tolist(aws_acm_certificate.test.domain_validation_options)[0].resource_record_value]
+  ttl             = 60
+  type            = tolist(aws_acm_certificate.test.domain_validation_options)[0].resource_record_type
+  zone_id         = data.aws_route53_zone.test.zone_id
+}
+
+# Stands in for a validation record managed outside of this configuration.
+resource "aws_route53_record" "external" {
+  allow_overwrite = true
+  name            = tolist(aws_acm_certificate.test.domain_validation_options)[1].resource_record_name
+  records         = [tolist(aws_acm_certificate.test.domain_validation_options)[1].resource_record_value]
+  ttl             = 60
+  type            = tolist(aws_acm_certificate.test.domain_validation_options)[1].resource_record_type
+  zone_id         = data.aws_route53_zone.test.zone_id
+}
+
+resource "aws_acm_certificate_validation" "test" {
+  depends_on = [aws_route53_record.external]
+
+  certificate_arn              = aws_acm_certificate.test.arn
+  externally_validated_domains = [tolist(aws_acm_certificate.test.domain_validation_options)[1].domain_name]
+  polling_interval             = "5s"
+  validation_record_fqdns      = [aws_route53_record.test.fqdn]
+}
+`, domainName, subjectAlternativeName, rootZoneDomain)
+}
+
 func testAccCertificateValidationConfig_recordFQDNsWrongFQDN(domainName string) string {
 	return fmt.Sprintf(`
 resource "aws_acm_certificate" "test" {
@@ -6,8 +6,10 @@ package apigateway
 import (
 	"context"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/apigateway"
 	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -15,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -130,8 +133,16 @@ func resourceAccountRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendErrorf(diags, "reading API Gateway Account: %s", err)
 	}
 
+	cloudwatchRoleARN := aws.ToString(account.CloudwatchRoleArn)
+	// If the CloudWatch role has been deleted out of band API Gateway still reports its ARN,
+	// but every stage update that enables logging fails. Report drift so the role is reapplied.
+	if cloudwatchRoleARN != "" && !cloudwatchRoleExists(ctx, meta.(*conns.AWSClient), cloudwatchRoleARN) {
+		log.Printf("[WARN] API Gateway Account CloudWatch role (%s) not found, clearing", cloudwatchRoleARN)
+		cloudwatchRoleARN = ""
+	}
+
 	d.Set("api_key_version", account.ApiKeyVersion)
-	d.Set("cloudwatch_role_arn", account.CloudwatchRoleArn)
+	d.Set("cloudwatch_role_arn", cloudwatchRoleARN)
 	d.Set("features", account.Features)
 	if err := d.Set("throttle_settings", flattenThrottleSettings(account.ThrottleSettings)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting throttle_settings: %s", err)
@@ -140,6 +151,30 @@ func resourceAccountRead(ctx context.Context, d *schema.ResourceData, meta inter
 	return diags
 }
 
+// cloudwatchRoleExists returns whether the specified IAM role exists.
+// Any error other than "not found" (e.g. missing iam:GetRole permission) is treated as the role existing.
+func cloudwatchRoleExists(ctx context.Context, awsClient *conns.AWSClient, roleARN string) bool {
+	v, err := arn.Parse(roleARN)
+
+	if err != nil {
+		return true
+	}
+
+	name := v.Resource[strings.LastIndex(v.Resource, "/")+1:]
+
+	_, err = tfiam.FindRoleByName(ctx, awsClient.IAMClient(ctx), name)
+
+	if tfresource.NotFound(err) {
+		return false
+	}
+
+	if err != nil {
+		log.Printf("[DEBUG] Unable to read API Gateway Account CloudWatch role (%s): %s", roleARN, err)
+	}
+
+	return true
+}
+
 func findAccount(ctx context.Context, conn *apigateway.Client) (*apigateway.GetAccountOutput, error) {
 	input := &apigateway.GetAccountInput{}
 
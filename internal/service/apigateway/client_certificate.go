@@ -7,14 +7,17 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/apigateway"
 	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -59,14 +62,59 @@ func resourceClientCertificate() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"rotate_before_expiration_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			names.AttrTriggers: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			verify.SetTagsDiff,
+			customizeDiffClientCertificateRotation,
+		),
 	}
 }
 
+// customizeDiffClientCertificateRotation forces a new certificate to be generated once the
+// existing certificate is within rotate_before_expiration_days of its expiration date.
+func customizeDiffClientCertificateRotation(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	v, ok := diff.GetOk("rotate_before_expiration_days")
+	if !ok {
+		return nil
+	}
+
+	expirationDate, err := time.Parse(clientCertificateDateLayout, diff.Get("expiration_date").(string))
+	if err != nil {
+		return nil
+	}
+
+	if time.Until(expirationDate) > time.Duration(v.(int))*24*time.Hour {
+		return nil
+	}
+
+	if err := diff.SetNewComputed("expiration_date"); err != nil {
+		return err
+	}
+
+	return diff.ForceNew("expiration_date")
+}
+
+// clientCertificateDateLayout is the layout of time.Time's String method, used for the created_date and expiration_date attributes.
+const clientCertificateDateLayout = "2006-01-02 15:04:05.999999999 -0700 MST"
+
 func resourceClientCertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).APIGatewayClient(ctx)
@@ -127,7 +175,7 @@ func resourceClientCertificateUpdate(ctx context.Context, d *schema.ResourceData
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).APIGatewayClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChange(names.AttrDescription) {
 		input := &apigateway.UpdateClientCertificateInput{
 			ClientCertificateId: aws.String(d.Id()),
 			PatchOperations: []types.PatchOperation{
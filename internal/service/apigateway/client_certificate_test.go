@@ -11,6 +11,7 @@ import (
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/apigateway"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -78,6 +79,42 @@ func TestAccAPIGatewayClientCertificate_disappears(t *testing.T) {
 	})
 }
 
+func TestAccAPIGatewayClientCertificate_triggers(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf apigateway.GetClientCertificateOutput
+	resourceName := "aws_api_gateway_client_certificate.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClientCertificateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClientCertificateConfig_triggers("1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClientCertificateExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "triggers.rotation", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rotate_before_expiration_days", "30"),
+				),
+			},
+			{
+				Config: testAccClientCertificateConfig_triggers("2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClientCertificateExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "triggers.rotation", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckClientCertificateExists(ctx context.Context, n string, v *apigateway.GetClientCertificateOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -136,3 +173,20 @@ resource "aws_api_gateway_client_certificate" "test" {
   description = "Hello from TF acceptance test - updated"
 }
 `
+
+func testAccClientCertificateConfig_triggers(rotation string) string {
+	return fmt.Sprintf(`
+resource "aws_api_gateway_client_certificate" "test" {
+  description                   = "Hello from TF acceptance test"
+  rotate_before_expiration_days = 30
+
+  triggers = {
+    rotation = %[1]q
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, rotation)
+}
@@ -0,0 +1,550 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cleanrooms_configured_table_analysis_rule")
+func ResourceConfiguredTableAnalysisRule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfiguredTableAnalysisRuleCreate,
+		ReadWithoutTimeout:   resourceConfiguredTableAnalysisRuleRead,
+		UpdateWithoutTimeout: resourceConfiguredTableAnalysisRuleUpdate,
+		DeleteWithoutTimeout: resourceConfiguredTableAnalysisRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(1 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"aggregation": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"aggregation", "custom", "list"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aggregate_columns": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"column_names": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"function": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[types.AggregateFunctionName](),
+									},
+								},
+							},
+						},
+						"allowed_join_operators": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[types.JoinOperator](),
+							},
+						},
+						"dimension_columns": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"join_columns": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"join_required": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[types.JoinRequiredOption](),
+						},
+						"output_constraints": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"column_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"minimum": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(2),
+									},
+									names.AttrType: {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[types.AggregationType](),
+									},
+								},
+							},
+						},
+						"scalar_functions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[types.ScalarFunctions](),
+							},
+						},
+					},
+				},
+			},
+			"analysis_rule_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.ConfiguredTableAnalysisRuleType](),
+			},
+			"configured_table_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configured_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrCreateTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"custom": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"aggregation", "custom", "list"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_analyses": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"allowed_analysis_providers": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"differential_privacy_columns": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"list": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"aggregation", "custom", "list"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_join_operators": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[types.JoinOperator](),
+							},
+						},
+						"join_columns": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"list_columns": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: resourceConfiguredTableAnalysisRuleCustomizeDiff,
+	}
+}
+
+const (
+	ResNameConfiguredTableAnalysisRule = "Configured Table Analysis Rule"
+
+	configuredTableAnalysisRuleIDPartCount = 2
+)
+
+// analysisRulePolicyBlocks maps each analysis rule type to the policy block it is configured with.
+var analysisRulePolicyBlocks = map[types.ConfiguredTableAnalysisRuleType]string{
+	types.ConfiguredTableAnalysisRuleTypeAggregation: "aggregation",
+	types.ConfiguredTableAnalysisRuleTypeCustom:      "custom",
+	types.ConfiguredTableAnalysisRuleTypeList:        "list",
+}
+
+func resourceConfiguredTableAnalysisRuleCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	ruleType := types.ConfiguredTableAnalysisRuleType(d.Get("analysis_rule_type").(string))
+	block, ok := analysisRulePolicyBlocks[ruleType]
+	if !ok {
+		return nil
+	}
+
+	if v, ok := d.GetOk(block); !ok || len(v.([]interface{})) == 0 {
+		return fmt.Errorf("analysis_rule_type %q requires a %q block", ruleType, block)
+	}
+
+	return nil
+}
+
+func resourceConfiguredTableAnalysisRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	configuredTableID := d.Get("configured_table_id").(string)
+	ruleType := d.Get("analysis_rule_type").(string)
+	id, err := flex.FlattenResourceId([]string{configuredTableID, ruleType}, configuredTableAnalysisRuleIDPartCount, false)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionFlatteningResourceId, ResNameConfiguredTableAnalysisRule, configuredTableID, err)
+	}
+
+	input := &cleanrooms.CreateConfiguredTableAnalysisRuleInput{
+		AnalysisRulePolicy:        expandConfiguredTableAnalysisRulePolicy(d),
+		AnalysisRuleType:          types.ConfiguredTableAnalysisRuleType(ruleType),
+		ConfiguredTableIdentifier: aws.String(configuredTableID),
+	}
+
+	out, err := conn.CreateConfiguredTableAnalysisRule(ctx, input)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionCreating, ResNameConfiguredTableAnalysisRule, id, err)
+	}
+
+	if out == nil || out.AnalysisRule == nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionCreating, ResNameConfiguredTableAnalysisRule, id, errors.New("empty output"))
+	}
+	d.SetId(id)
+
+	return append(diags, resourceConfiguredTableAnalysisRuleRead(ctx, d, meta)...)
+}
+
+func resourceConfiguredTableAnalysisRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), configuredTableAnalysisRuleIDPartCount, false)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionReading, ResNameConfiguredTableAnalysisRule, d.Id(), err)
+	}
+
+	out, err := findConfiguredTableAnalysisRuleByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Clean Rooms Configured Table Analysis Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionReading, ResNameConfiguredTableAnalysisRule, d.Id(), err)
+	}
+
+	analysisRule := out.AnalysisRule
+	d.Set("analysis_rule_type", analysisRule.Type)
+	d.Set("configured_table_arn", analysisRule.ConfiguredTableArn)
+	d.Set("configured_table_id", analysisRule.ConfiguredTableId)
+	d.Set(names.AttrCreateTime, analysisRule.CreateTime.String())
+	d.Set("update_time", analysisRule.UpdateTime.String())
+
+	var aggregation, custom, list []interface{}
+	if v, ok := analysisRule.Policy.(*types.ConfiguredTableAnalysisRulePolicyMemberV1); ok {
+		switch v := v.Value.(type) {
+		case *types.ConfiguredTableAnalysisRulePolicyV1MemberAggregation:
+			aggregation = flattenAnalysisRuleAggregation(&v.Value)
+		case *types.ConfiguredTableAnalysisRulePolicyV1MemberCustom:
+			custom = flattenAnalysisRuleCustom(&v.Value)
+		case *types.ConfiguredTableAnalysisRulePolicyV1MemberList:
+			list = flattenAnalysisRuleList(&v.Value)
+		}
+	}
+
+	if err := d.Set("aggregation", aggregation); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting aggregation: %s", err)
+	}
+	if err := d.Set("custom", custom); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting custom: %s", err)
+	}
+	if err := d.Set("list", list); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting list: %s", err)
+	}
+
+	return diags
+}
+
+func resourceConfiguredTableAnalysisRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	if d.HasChanges("aggregation", "custom", "list") {
+		input := &cleanrooms.UpdateConfiguredTableAnalysisRuleInput{
+			AnalysisRulePolicy:        expandConfiguredTableAnalysisRulePolicy(d),
+			AnalysisRuleType:          types.ConfiguredTableAnalysisRuleType(d.Get("analysis_rule_type").(string)),
+			ConfiguredTableIdentifier: aws.String(d.Get("configured_table_id").(string)),
+		}
+
+		_, err := conn.UpdateConfiguredTableAnalysisRule(ctx, input)
+		if err != nil {
+			return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionUpdating, ResNameConfiguredTableAnalysisRule, d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceConfiguredTableAnalysisRuleRead(ctx, d, meta)...)
+}
+
+func resourceConfiguredTableAnalysisRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	log.Printf("[INFO] Deleting Clean Rooms Configured Table Analysis Rule %s", d.Id())
+	_, err := conn.DeleteConfiguredTableAnalysisRule(ctx, &cleanrooms.DeleteConfiguredTableAnalysisRuleInput{
+		AnalysisRuleType:          types.ConfiguredTableAnalysisRuleType(d.Get("analysis_rule_type").(string)),
+		ConfiguredTableIdentifier: aws.String(d.Get("configured_table_id").(string)),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionDeleting, ResNameConfiguredTableAnalysisRule, d.Id(), err)
+	}
+
+	return diags
+}
+
+func findConfiguredTableAnalysisRuleByTwoPartKey(ctx context.Context, conn *cleanrooms.Client, configuredTableID, ruleType string) (*cleanrooms.GetConfiguredTableAnalysisRuleOutput, error) {
+	in := &cleanrooms.GetConfiguredTableAnalysisRuleInput{
+		AnalysisRuleType:          types.ConfiguredTableAnalysisRuleType(ruleType),
+		ConfiguredTableIdentifier: aws.String(configuredTableID),
+	}
+
+	out, err := conn.GetConfiguredTableAnalysisRule(ctx, in)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.AnalysisRule == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func expandConfiguredTableAnalysisRulePolicy(d *schema.ResourceData) types.ConfiguredTableAnalysisRulePolicy {
+	var policy types.ConfiguredTableAnalysisRulePolicyV1
+
+	if v, ok := d.GetOk("aggregation"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		policy = &types.ConfiguredTableAnalysisRulePolicyV1MemberAggregation{
+			Value: expandAnalysisRuleAggregation(v.([]interface{})[0].(map[string]interface{})),
+		}
+	} else if v, ok := d.GetOk("custom"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		policy = &types.ConfiguredTableAnalysisRulePolicyV1MemberCustom{
+			Value: expandAnalysisRuleCustom(v.([]interface{})[0].(map[string]interface{})),
+		}
+	} else if v, ok := d.GetOk("list"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		policy = &types.ConfiguredTableAnalysisRulePolicyV1MemberList{
+			Value: expandAnalysisRuleList(v.([]interface{})[0].(map[string]interface{})),
+		}
+	}
+
+	return &types.ConfiguredTableAnalysisRulePolicyMemberV1{
+		Value: policy,
+	}
+}
+
+func expandAnalysisRuleAggregation(tfMap map[string]interface{}) types.AnalysisRuleAggregation {
+	apiObject := types.AnalysisRuleAggregation{
+		DimensionColumns: flex.ExpandStringValueSet(tfMap["dimension_columns"].(*schema.Set)),
+		JoinColumns:      flex.ExpandStringValueSet(tfMap["join_columns"].(*schema.Set)),
+		ScalarFunctions:  flex.ExpandStringyValueSet[types.ScalarFunctions](tfMap["scalar_functions"].(*schema.Set)),
+	}
+
+	for _, v := range tfMap["aggregate_columns"].([]interface{}) {
+		column := v.(map[string]interface{})
+		apiObject.AggregateColumns = append(apiObject.AggregateColumns, types.AggregateColumn{
+			ColumnNames: flex.ExpandStringValueSet(column["column_names"].(*schema.Set)),
+			Function:    types.AggregateFunctionName(column["function"].(string)),
+		})
+	}
+
+	if v, ok := tfMap["allowed_join_operators"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowedJoinOperators = flex.ExpandStringyValueSet[types.JoinOperator](v)
+	}
+
+	if v, ok := tfMap["join_required"].(string); ok && v != "" {
+		apiObject.JoinRequired = types.JoinRequiredOption(v)
+	}
+
+	for _, v := range tfMap["output_constraints"].([]interface{}) {
+		constraint := v.(map[string]interface{})
+		apiObject.OutputConstraints = append(apiObject.OutputConstraints, types.AggregationConstraint{
+			ColumnName: aws.String(constraint["column_name"].(string)),
+			Minimum:    aws.Int32(int32(constraint["minimum"].(int))),
+			Type:       types.AggregationType(constraint[names.AttrType].(string)),
+		})
+	}
+
+	return apiObject
+}
+
+func expandAnalysisRuleCustom(tfMap map[string]interface{}) types.AnalysisRuleCustom {
+	apiObject := types.AnalysisRuleCustom{
+		AllowedAnalyses: flex.ExpandStringValueSet(tfMap["allowed_analyses"].(*schema.Set)),
+	}
+
+	if v, ok := tfMap["allowed_analysis_providers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowedAnalysisProviders = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["differential_privacy_columns"].(*schema.Set); ok && v.Len() > 0 {
+		columns := make([]types.DifferentialPrivacyColumn, 0, v.Len())
+		for _, name := range flex.ExpandStringValueSet(v) {
+			columns = append(columns, types.DifferentialPrivacyColumn{
+				Name: aws.String(name),
+			})
+		}
+		apiObject.DifferentialPrivacy = &types.DifferentialPrivacyConfiguration{
+			Columns: columns,
+		}
+	}
+
+	return apiObject
+}
+
+func expandAnalysisRuleList(tfMap map[string]interface{}) types.AnalysisRuleList {
+	apiObject := types.AnalysisRuleList{
+		JoinColumns: flex.ExpandStringValueSet(tfMap["join_columns"].(*schema.Set)),
+		ListColumns: flex.ExpandStringValueSet(tfMap["list_columns"].(*schema.Set)),
+	}
+
+	if v, ok := tfMap["allowed_join_operators"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowedJoinOperators = flex.ExpandStringyValueSet[types.JoinOperator](v)
+	}
+
+	return apiObject
+}
+
+func flattenAnalysisRuleAggregation(apiObject *types.AnalysisRuleAggregation) []interface{} {
+	aggregateColumns := make([]interface{}, 0, len(apiObject.AggregateColumns))
+	for _, v := range apiObject.AggregateColumns {
+		aggregateColumns = append(aggregateColumns, map[string]interface{}{
+			"column_names": v.ColumnNames,
+			"function":     string(v.Function),
+		})
+	}
+
+	outputConstraints := make([]interface{}, 0, len(apiObject.OutputConstraints))
+	for _, v := range apiObject.OutputConstraints {
+		outputConstraints = append(outputConstraints, map[string]interface{}{
+			"column_name":  aws.ToString(v.ColumnName),
+			"minimum":      int(aws.ToInt32(v.Minimum)),
+			names.AttrType: string(v.Type),
+		})
+	}
+
+	tfMap := map[string]interface{}{
+		"aggregate_columns":      aggregateColumns,
+		"allowed_join_operators": flex.FlattenStringyValueList(apiObject.AllowedJoinOperators),
+		"dimension_columns":      apiObject.DimensionColumns,
+		"join_columns":           apiObject.JoinColumns,
+		"join_required":          string(apiObject.JoinRequired),
+		"output_constraints":     outputConstraints,
+		"scalar_functions":       flex.FlattenStringyValueList(apiObject.ScalarFunctions),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAnalysisRuleCustom(apiObject *types.AnalysisRuleCustom) []interface{} {
+	tfMap := map[string]interface{}{
+		"allowed_analyses":           apiObject.AllowedAnalyses,
+		"allowed_analysis_providers": apiObject.AllowedAnalysisProviders,
+	}
+
+	if apiObject.DifferentialPrivacy != nil {
+		columns := make([]string, 0, len(apiObject.DifferentialPrivacy.Columns))
+		for _, v := range apiObject.DifferentialPrivacy.Columns {
+			columns = append(columns, aws.ToString(v.Name))
+		}
+		tfMap["differential_privacy_columns"] = columns
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAnalysisRuleList(apiObject *types.AnalysisRuleList) []interface{} {
+	tfMap := map[string]interface{}{
+		"allowed_join_operators": flex.FlattenStringyValueList(apiObject.AllowedJoinOperators),
+		"join_columns":           apiObject.JoinColumns,
+		"list_columns":           apiObject.ListColumns,
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,267 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCleanRoomsConfiguredTableAnalysisRule_list(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var analysisRule cleanrooms.GetConfiguredTableAnalysisRuleOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_analysis_rule.test"
+	configuredTableResourceName := "aws_cleanrooms_configured_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAnalysisRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_list(rName, `["my_column_2"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, &analysisRule),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_type", "LIST"),
+					resource.TestCheckResourceAttrPair(resourceName, "configured_table_arn", configuredTableResourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "configured_table_id", configuredTableResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "list.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "list.0.join_columns.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "list.0.join_columns.*", "my_column_1"),
+					resource.TestCheckResourceAttr(resourceName, "list.0.list_columns.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "list.0.list_columns.*", "my_column_2"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "custom.#", acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_list(rName, `["my_column_1", "my_column_2"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, &analysisRule),
+					resource.TestCheckResourceAttr(resourceName, "list.0.list_columns.#", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTableAnalysisRule_aggregation(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var analysisRule cleanrooms.GetConfiguredTableAnalysisRuleOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_analysis_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAnalysisRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_aggregation(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, &analysisRule),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_type", "AGGREGATION"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.0.aggregate_columns.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.0.aggregate_columns.0.function", "COUNT_DISTINCT"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.0.join_required", "QUERY_RUNNER"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.0.output_constraints.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.0.output_constraints.0.minimum", "100"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.0.scalar_functions.#", acctest.Ct2),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTableAnalysisRule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var analysisRule cleanrooms.GetConfiguredTableAnalysisRuleOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_analysis_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAnalysisRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_list(rName, `["my_column_2"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, &analysisRule),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcleanrooms.ResourceConfiguredTableAnalysisRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTableAnalysisRule_policyMismatch(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAnalysisRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccConfiguredTableAnalysisRuleConfig_policyMismatch(rName),
+				ExpectError: regexache.MustCompile(`analysis_rule_type "AGGREGATION" requires a "aggregation" block`),
+			},
+		},
+	})
+}
+
+func testAccCheckConfiguredTableAnalysisRuleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cleanrooms_configured_table_analysis_rule" {
+				continue
+			}
+
+			_, err := conn.GetConfiguredTableAnalysisRule(ctx, &cleanrooms.GetConfiguredTableAnalysisRuleInput{
+				AnalysisRuleType:          types.ConfiguredTableAnalysisRuleType(rs.Primary.Attributes["analysis_rule_type"]),
+				ConfiguredTableIdentifier: aws.String(rs.Primary.Attributes["configured_table_id"]),
+			})
+
+			if errs.IsA[*types.ResourceNotFoundException](err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.CleanRooms, create.ErrActionCheckingDestroyed, tfcleanrooms.ResNameConfiguredTableAnalysisRule, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckConfiguredTableAnalysisRuleExists(ctx context.Context, name string, analysisRule *cleanrooms.GetConfiguredTableAnalysisRuleOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameConfiguredTableAnalysisRule, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameConfiguredTableAnalysisRule, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+		resp, err := conn.GetConfiguredTableAnalysisRule(ctx, &cleanrooms.GetConfiguredTableAnalysisRuleInput{
+			AnalysisRuleType:          types.ConfiguredTableAnalysisRuleType(rs.Primary.Attributes["analysis_rule_type"]),
+			ConfiguredTableIdentifier: aws.String(rs.Primary.Attributes["configured_table_id"]),
+		})
+
+		if err != nil {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameConfiguredTableAnalysisRule, rs.Primary.ID, err)
+		}
+
+		*analysisRule = *resp
+
+		return nil
+	}
+}
+
+func testAccConfiguredTableAnalysisRuleConfig_base(rName string) string {
+	return testAccConfiguredTableConfig(rName, TEST_NAME, TEST_DESCRIPTION, TEST_TAG, TEST_ALLOWED_COLUMNS,
+		TEST_ANALYSIS_METHOD, rName, rName)
+}
+
+func testAccConfiguredTableAnalysisRuleConfig_list(rName, listColumns string) string {
+	return acctest.ConfigCompose(testAccConfiguredTableAnalysisRuleConfig_base(rName), fmt.Sprintf(`
+resource "aws_cleanrooms_configured_table_analysis_rule" "test" {
+  configured_table_id = aws_cleanrooms_configured_table.test.id
+  analysis_rule_type  = "LIST"
+
+  list {
+    join_columns = ["my_column_1"]
+    list_columns = %[1]s
+  }
+}
+`, listColumns))
+}
+
+func testAccConfiguredTableAnalysisRuleConfig_aggregation(rName string) string {
+	return acctest.ConfigCompose(testAccConfiguredTableAnalysisRuleConfig_base(rName), `
+resource "aws_cleanrooms_configured_table_analysis_rule" "test" {
+  configured_table_id = aws_cleanrooms_configured_table.test.id
+  analysis_rule_type  = "AGGREGATION"
+
+  aggregation {
+    dimension_columns = ["my_column_2"]
+    join_columns      = ["my_column_1"]
+    join_required     = "QUERY_RUNNER"
+    scalar_functions  = ["LOWER", "TRIM"]
+
+    aggregate_columns {
+      column_names = ["my_column_1"]
+      function     = "COUNT_DISTINCT"
+    }
+
+    output_constraints {
+      column_name = "my_column_1"
+      minimum     = 100
+      type        = "COUNT_DISTINCT"
+    }
+  }
+}
+`)
+}
+
+func testAccConfiguredTableAnalysisRuleConfig_policyMismatch(rName string) string {
+	return acctest.ConfigCompose(testAccConfiguredTableAnalysisRuleConfig_base(rName), `
+resource "aws_cleanrooms_configured_table_analysis_rule" "test" {
+  configured_table_id = aws_cleanrooms_configured_table.test.id
+  analysis_rule_type  = "AGGREGATION"
+
+  list {
+    join_columns = ["my_column_1"]
+    list_columns = ["my_column_2"]
+  }
+}
+`)
+}
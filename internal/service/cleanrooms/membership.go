@@ -0,0 +1,363 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cleanrooms_membership")
+// @Tags(identifierAttribute="arn")
+func ResourceMembership() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMembershipCreate,
+		ReadWithoutTimeout:   resourceMembershipRead,
+		UpdateWithoutTimeout: resourceMembershipUpdate,
+		DeleteWithoutTimeout: resourceMembershipDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(1 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_creator_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_creator_display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"collaboration_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrCreateTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_result_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"output_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"s3": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrBucket: {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"key_prefix": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"result_format": {
+													Type:             schema.TypeString,
+													Required:         true,
+													ValidateDiagFunc: enum.Validate[types.ResultFormat](),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						names.AttrRoleARN: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"member_abilities": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"query_log_status": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.MembershipQueryLogStatus](),
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			// The API has no way to clear the default result configuration once set.
+			customdiff.ForceNewIfChange("default_result_configuration", func(_ context.Context, old, new, meta interface{}) bool {
+				return len(old.([]interface{})) > 0 && len(new.([]interface{})) == 0
+			}),
+		),
+	}
+}
+
+const (
+	ResNameMembership = "Membership"
+)
+
+func resourceMembershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	collaborationID := d.Get("collaboration_id").(string)
+	input := &cleanrooms.CreateMembershipInput{
+		CollaborationIdentifier: aws.String(collaborationID),
+		QueryLogStatus:          types.MembershipQueryLogStatus(d.Get("query_log_status").(string)),
+		Tags:                    getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("default_result_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DefaultResultConfiguration = expandMembershipProtectedQueryResultConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	out, err := conn.CreateMembership(ctx, input)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionCreating, ResNameMembership, collaborationID, err)
+	}
+
+	if out == nil || out.Membership == nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionCreating, ResNameMembership, collaborationID, errors.New("empty output"))
+	}
+	d.SetId(aws.ToString(out.Membership.Id))
+
+	return append(diags, resourceMembershipRead(ctx, d, meta)...)
+}
+
+func resourceMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	out, err := findMembershipByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Clean Rooms Membership (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionReading, ResNameMembership, d.Id(), err)
+	}
+
+	membership := out.Membership
+	d.Set(names.AttrARN, membership.Arn)
+	d.Set("collaboration_arn", membership.CollaborationArn)
+	d.Set("collaboration_creator_account_id", membership.CollaborationCreatorAccountId)
+	d.Set("collaboration_creator_display_name", membership.CollaborationCreatorDisplayName)
+	d.Set("collaboration_id", membership.CollaborationId)
+	d.Set("collaboration_name", membership.CollaborationName)
+	d.Set(names.AttrCreateTime, membership.CreateTime.String())
+	d.Set("member_abilities", flattenMemberAbilities(membership.MemberAbilities))
+	d.Set("query_log_status", membership.QueryLogStatus)
+	d.Set(names.AttrStatus, membership.Status)
+	d.Set("update_time", membership.UpdateTime.String())
+
+	if err := d.Set("default_result_configuration", flattenMembershipProtectedQueryResultConfiguration(membership.DefaultResultConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting default_result_configuration: %s", err)
+	}
+
+	return diags
+}
+
+func resourceMembershipUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &cleanrooms.UpdateMembershipInput{
+			MembershipIdentifier: aws.String(d.Id()),
+		}
+
+		if d.HasChange("default_result_configuration") {
+			if v, ok := d.GetOk("default_result_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.DefaultResultConfiguration = expandMembershipProtectedQueryResultConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("query_log_status") {
+			input.QueryLogStatus = types.MembershipQueryLogStatus(d.Get("query_log_status").(string))
+		}
+
+		_, err := conn.UpdateMembership(ctx, input)
+		if err != nil {
+			return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionUpdating, ResNameMembership, d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceMembershipRead(ctx, d, meta)...)
+}
+
+func resourceMembershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	log.Printf("[INFO] Deleting Clean Rooms Membership %s", d.Id())
+	_, err := conn.DeleteMembership(ctx, &cleanrooms.DeleteMembershipInput{
+		MembershipIdentifier: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionDeleting, ResNameMembership, d.Id(), err)
+	}
+
+	return diags
+}
+
+func findMembershipByID(ctx context.Context, conn *cleanrooms.Client, id string) (*cleanrooms.GetMembershipOutput, error) {
+	in := &cleanrooms.GetMembershipInput{
+		MembershipIdentifier: aws.String(id),
+	}
+
+	out, err := conn.GetMembership(ctx, in)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.Membership == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	if status := out.Membership.Status; status == types.MembershipStatusRemoved {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: in,
+		}
+	}
+
+	return out, nil
+}
+
+func expandMembershipProtectedQueryResultConfiguration(tfMap map[string]interface{}) *types.MembershipProtectedQueryResultConfiguration {
+	apiObject := &types.MembershipProtectedQueryResultConfiguration{}
+
+	if v, ok := tfMap["output_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.OutputConfiguration = expandMembershipProtectedQueryOutputConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap[names.AttrRoleARN].(string); ok && v != "" {
+		apiObject.RoleArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandMembershipProtectedQueryOutputConfiguration(tfMap map[string]interface{}) types.MembershipProtectedQueryOutputConfiguration {
+	v, ok := tfMap["s3"].([]interface{})
+	if !ok || len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	s3 := v[0].(map[string]interface{})
+	apiObject := types.ProtectedQueryS3OutputConfiguration{
+		Bucket:       aws.String(s3[names.AttrBucket].(string)),
+		ResultFormat: types.ResultFormat(s3["result_format"].(string)),
+	}
+
+	if v, ok := s3["key_prefix"].(string); ok && v != "" {
+		apiObject.KeyPrefix = aws.String(v)
+	}
+
+	return &types.MembershipProtectedQueryOutputConfigurationMemberS3{
+		Value: apiObject,
+	}
+}
+
+func flattenMembershipProtectedQueryResultConfiguration(apiObject *types.MembershipProtectedQueryResultConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrRoleARN: aws.ToString(apiObject.RoleArn),
+	}
+
+	switch v := apiObject.OutputConfiguration.(type) {
+	case *types.MembershipProtectedQueryOutputConfigurationMemberS3:
+		tfMap["output_configuration"] = []interface{}{map[string]interface{}{
+			"s3": []interface{}{map[string]interface{}{
+				names.AttrBucket: aws.ToString(v.Value.Bucket),
+				"key_prefix":     aws.ToString(v.Value.KeyPrefix),
+				"result_format":  string(v.Value.ResultFormat),
+			}},
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,249 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCleanRoomsMembership_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var membership cleanrooms.GetMembershipOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_membership.test"
+	collaborationResourceName := "aws_cleanrooms_collaboration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMembershipDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMembershipConfig_basic(rName, "DISABLED", TEST_TAG),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMembershipExists(ctx, resourceName, &membership),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "cleanrooms", regexache.MustCompile(`membership/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "collaboration_arn", collaborationResourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "collaboration_id", collaborationResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "collaboration_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "member_abilities.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "query_log_status", "DISABLED"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "default_result_configuration.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "tags.Project", TEST_TAG),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsMembership_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var membership cleanrooms.GetMembershipOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_membership.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMembershipDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMembershipConfig_basic(rName, "DISABLED", TEST_TAG),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMembershipExists(ctx, resourceName, &membership),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcleanrooms.ResourceMembership(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsMembership_mutableProperties(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var membership cleanrooms.GetMembershipOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_membership.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMembershipDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMembershipConfig_basic(rName, "DISABLED", TEST_TAG),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMembershipExists(ctx, resourceName, &membership),
+				),
+			},
+			{
+				Config: testAccMembershipConfig_defaultResultConfiguration(rName, "ENABLED", "updated tag"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMembershipIsTheSame(resourceName, &membership),
+					resource.TestCheckResourceAttr(resourceName, "query_log_status", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "default_result_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "default_result_configuration.0.output_configuration.0.s3.0.bucket", "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "default_result_configuration.0.output_configuration.0.s3.0.key_prefix", "results/"),
+					resource.TestCheckResourceAttr(resourceName, "default_result_configuration.0.output_configuration.0.s3.0.result_format", "PARQUET"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Project", "updated tag"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMembershipDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cleanrooms_membership" {
+				continue
+			}
+
+			out, err := conn.GetMembership(ctx, &cleanrooms.GetMembershipInput{
+				MembershipIdentifier: aws.String(rs.Primary.ID),
+			})
+
+			if errs.IsA[*types.ResourceNotFoundException](err) || errs.IsA[*types.AccessDeniedException](err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if out.Membership != nil && out.Membership.Status != types.MembershipStatusActive {
+				continue
+			}
+
+			return create.Error(names.CleanRooms, create.ErrActionCheckingDestroyed, tfcleanrooms.ResNameMembership, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMembershipExists(ctx context.Context, name string, membership *cleanrooms.GetMembershipOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameMembership, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameMembership, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+		resp, err := conn.GetMembership(ctx, &cleanrooms.GetMembershipInput{
+			MembershipIdentifier: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameMembership, rs.Primary.ID, err)
+		}
+
+		*membership = *resp
+
+		return nil
+	}
+}
+
+func testAccCheckMembershipIsTheSame(name string, membership *cleanrooms.GetMembershipOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameMembership, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID != aws.ToString(membership.Membership.Id) {
+			return fmt.Errorf("Membership was recreated. Expected ID %s, got %s", aws.ToString(membership.Membership.Id), rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccMembershipConfig_collaboration(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cleanrooms_collaboration" "test" {
+  name                     = %[1]q
+  creator_member_abilities = ["CAN_QUERY", "CAN_RECEIVE_RESULTS"]
+  creator_display_name     = "creator"
+  description              = "test"
+  query_log_status         = "ENABLED"
+}
+`, rName)
+}
+
+func testAccMembershipConfig_basic(rName, queryLogStatus, tagValue string) string {
+	return acctest.ConfigCompose(testAccMembershipConfig_collaboration(rName), fmt.Sprintf(`
+resource "aws_cleanrooms_membership" "test" {
+  collaboration_id = aws_cleanrooms_collaboration.test.id
+  query_log_status = %[1]q
+
+  tags = {
+    Project = %[2]q
+  }
+}
+`, queryLogStatus, tagValue))
+}
+
+func testAccMembershipConfig_defaultResultConfiguration(rName, queryLogStatus, tagValue string) string {
+	return acctest.ConfigCompose(testAccMembershipConfig_collaboration(rName), fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_cleanrooms_membership" "test" {
+  collaboration_id = aws_cleanrooms_collaboration.test.id
+  query_log_status = %[2]q
+
+  default_result_configuration {
+    output_configuration {
+      s3 {
+        bucket        = aws_s3_bucket.test.bucket
+        key_prefix    = "results/"
+        result_format = "PARQUET"
+      }
+    }
+  }
+
+  tags = {
+    Project = %[3]q
+  }
+}
+`, rName, queryLogStatus, tagValue))
+}
@@ -42,6 +42,17 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  ResourceConfiguredTableAnalysisRule,
+			TypeName: "aws_cleanrooms_configured_table_analysis_rule",
+		},
+		{
+			Factory:  ResourceMembership,
+			TypeName: "aws_cleanrooms_membership",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 	}
 }
 
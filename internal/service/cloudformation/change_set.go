@@ -43,6 +43,43 @@ func findChangeSetByTwoPartKey(ctx context.Context, conn *cloudformation.Client,
 	return output, nil
 }
 
+func findChangeSetChangesByTwoPartKey(ctx context.Context, conn *cloudformation.Client, stackID, changeSetName string) ([]awstypes.Change, error) {
+	input := &cloudformation.DescribeChangeSetInput{
+		ChangeSetName: aws.String(changeSetName),
+		StackName:     aws.String(stackID),
+	}
+	var output []awstypes.Change
+
+	for {
+		page, err := conn.DescribeChangeSet(ctx, input)
+
+		if errs.IsA[*awstypes.ChangeSetNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if page == nil {
+			break
+		}
+
+		output = append(output, page.Changes...)
+
+		if aws.ToString(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
+
 func statusChangeSet(ctx context.Context, conn *cloudformation.Client, stackID, changeSetName string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findChangeSetByTwoPartKey(ctx, conn, stackID, changeSetName)
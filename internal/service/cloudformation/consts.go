@@ -10,3 +10,13 @@ import (
 const (
 	propagationTimeout = 2 * time.Minute
 )
+
+const (
+	rollbackTriggerTypeCloudWatchAlarm = "AWS::CloudWatch::Alarm"
+)
+
+func rollbackTriggerType_Values() []string {
+	return []string{
+		rollbackTriggerTypeCloudWatchAlarm,
+	}
+}
@@ -6,6 +6,8 @@ package cloudformation
 import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func expandParameters(params map[string]interface{}) []awstypes.Parameter {
@@ -50,3 +52,54 @@ func flattenParameters(cfParams []awstypes.Parameter,
 	}
 	return params
 }
+
+func expandRollbackConfiguration(tfMap map[string]interface{}) *awstypes.RollbackConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.RollbackConfiguration{
+		RollbackTriggers: []awstypes.RollbackTrigger{},
+	}
+
+	if v, ok := tfMap["monitoring_time_in_minutes"].(int); ok {
+		apiObject.MonitoringTimeInMinutes = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["rollback_trigger"].(*schema.Set); ok {
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			apiObject.RollbackTriggers = append(apiObject.RollbackTriggers, awstypes.RollbackTrigger{
+				Arn:  aws.String(tfMap[names.AttrARN].(string)),
+				Type: aws.String(tfMap[names.AttrType].(string)),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func flattenRollbackConfiguration(apiObject *awstypes.RollbackConfiguration) []interface{} {
+	if apiObject == nil || (aws.ToInt32(apiObject.MonitoringTimeInMinutes) == 0 && len(apiObject.RollbackTriggers) == 0) {
+		return nil
+	}
+
+	var rollbackTriggers []interface{}
+	for _, v := range apiObject.RollbackTriggers {
+		rollbackTriggers = append(rollbackTriggers, map[string]interface{}{
+			names.AttrARN:  aws.ToString(v.Arn),
+			names.AttrType: aws.ToString(v.Type),
+		})
+	}
+
+	tfMap := map[string]interface{}{
+		"monitoring_time_in_minutes": aws.ToInt32(apiObject.MonitoringTimeInMinutes),
+		"rollback_trigger":           rollbackTriggers,
+	}
+
+	return []interface{}{tfMap}
+}
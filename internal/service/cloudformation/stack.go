@@ -111,6 +111,40 @@ func resourceStack() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"rollback_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"monitoring_time_in_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 180),
+						},
+						"rollback_trigger": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 5,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrARN: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									names.AttrType: {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      rollbackTriggerTypeCloudWatchAlarm,
+										ValidateFunc: validation.StringInSlice(rollbackTriggerType_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"template_body": {
@@ -132,6 +166,11 @@ func resourceStack() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"use_change_set": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 
 		CustomizeDiff: customdiff.All(
@@ -181,6 +220,9 @@ func resourceStackCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	if v, ok := d.GetOk("policy_url"); ok {
 		input.StackPolicyURL = aws.String(v.(string))
 	}
+	if v, ok := d.GetOk("rollback_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.RollbackConfiguration = expandRollbackConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
 	if v, ok := d.GetOk("template_body"); ok {
 		template, err := verify.NormalizeJSONOrYAMLString(v)
 		if err != nil {
@@ -268,6 +310,9 @@ func resourceStackRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	if err := d.Set(names.AttrParameters, flattenParameters(stack.Parameters, d.Get(names.AttrParameters).(map[string]interface{}))); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting parameters: %s", err)
 	}
+	if err := d.Set("rollback_configuration", flattenRollbackConfiguration(stack.RollbackConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting rollback_configuration: %s", err)
+	}
 	d.Set("timeout_in_minutes", stack.TimeoutInMinutes)
 
 	setTagsOut(ctx, stack.Tags)
@@ -310,6 +355,17 @@ func resourceStackUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	if d.HasChange("policy_url") {
 		input.StackPolicyURL = aws.String(d.Get("policy_url").(string))
 	}
+	// If no rollback configuration is specified the stack's existing configuration is used,
+	// so an empty configuration must be sent to remove it.
+	if d.HasChange("rollback_configuration") {
+		if v, ok := d.GetOk("rollback_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.RollbackConfiguration = expandRollbackConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			input.RollbackConfiguration = &awstypes.RollbackConfiguration{
+				RollbackTriggers: []awstypes.RollbackTrigger{},
+			}
+		}
+	}
 	// Either TemplateBody, TemplateURL or UsePreviousTemplate are required
 	if v, ok := d.GetOk("template_url"); ok {
 		input.TemplateURL = aws.String(v.(string))
@@ -326,16 +382,28 @@ func resourceStackUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.Tags = tags
 	}
 
-	_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (interface{}, error) {
-		return conn.UpdateStack(ctx, input)
-	}, errCodeValidationError, "is invalid or cannot be assumed")
+	if d.Get("use_change_set").(bool) {
+		executed, err := updateStackWithChangeSet(ctx, conn, input)
 
-	if tfawserr.ErrMessageContains(err, errCodeValidationError, "No updates are to be performed") {
-		return append(diags, resourceStackRead(ctx, d, meta)...)
-	}
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CloudFormation Stack (%s) with change set: %s", d.Id(), err)
+		}
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "updating CloudFormation Stack (%s): %s", d.Id(), err)
+		if !executed {
+			return append(diags, resourceStackRead(ctx, d, meta)...)
+		}
+	} else {
+		_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (interface{}, error) {
+			return conn.UpdateStack(ctx, input)
+		}, errCodeValidationError, "is invalid or cannot be assumed")
+
+		if tfawserr.ErrMessageContains(err, errCodeValidationError, "No updates are to be performed") {
+			return append(diags, resourceStackRead(ctx, d, meta)...)
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CloudFormation Stack (%s): %s", d.Id(), err)
+		}
 	}
 
 	if _, err := waitStackUpdated(ctx, conn, d.Id(), requestToken, d.Timeout(schema.TimeoutUpdate)); err != nil {
@@ -371,6 +439,99 @@ func resourceStackDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	return diags
 }
 
+// updateStackWithChangeSet applies the stack update described by input via a change set.
+// The resource changes in the change set are logged before it is executed.
+// Returns false if the change set contains no changes and was therefore not executed.
+func updateStackWithChangeSet(ctx context.Context, conn *cloudformation.Client, input *cloudformation.UpdateStackInput) (bool, error) {
+	stackID := aws.ToString(input.StackName)
+
+	// Stack policies can't be set via change sets.
+	if input.StackPolicyBody != nil || input.StackPolicyURL != nil {
+		_, err := conn.SetStackPolicy(ctx, &cloudformation.SetStackPolicyInput{
+			StackName:       aws.String(stackID),
+			StackPolicyBody: input.StackPolicyBody,
+			StackPolicyURL:  input.StackPolicyURL,
+		})
+
+		if err != nil {
+			return false, fmt.Errorf("setting stack policy: %w", err)
+		}
+	}
+
+	changeSetName := id.PrefixedUniqueId("terraform-")
+	createInput := &cloudformation.CreateChangeSetInput{
+		Capabilities:          input.Capabilities,
+		ChangeSetName:         aws.String(changeSetName),
+		ChangeSetType:         awstypes.ChangeSetTypeUpdate,
+		NotificationARNs:      input.NotificationARNs,
+		Parameters:            input.Parameters,
+		RoleARN:               input.RoleARN,
+		RollbackConfiguration: input.RollbackConfiguration,
+		StackName:             aws.String(stackID),
+		Tags:                  input.Tags,
+		TemplateBody:          input.TemplateBody,
+		TemplateURL:           input.TemplateURL,
+	}
+
+	_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateChangeSet(ctx, createInput)
+	}, errCodeValidationError, "is invalid or cannot be assumed")
+
+	if err != nil {
+		return false, fmt.Errorf("creating change set (%s): %w", changeSetName, err)
+	}
+
+	changeSet, err := waitChangeSetCreated(ctx, conn, stackID, changeSetName)
+
+	if err != nil {
+		// Change sets that fail to create aren't removed automatically.
+		_, deleteErr := conn.DeleteChangeSet(ctx, &cloudformation.DeleteChangeSetInput{
+			ChangeSetName: aws.String(changeSetName),
+			StackName:     aws.String(stackID),
+		})
+
+		if deleteErr != nil {
+			deleteErr = fmt.Errorf("deleting change set (%s): %w", changeSetName, deleteErr)
+		}
+
+		if changeSet != nil && changeSet.Status == awstypes.ChangeSetStatusFailed && isEmptyChangeSetReason(aws.ToString(changeSet.StatusReason)) {
+			log.Printf("[DEBUG] CloudFormation Stack (%s) change set (%s) contains no changes", stackID, changeSetName)
+
+			return false, deleteErr
+		}
+
+		return false, errors.Join(fmt.Errorf("waiting for change set (%s) create: %w", changeSetName, err), deleteErr)
+	}
+
+	changes, err := findChangeSetChangesByTwoPartKey(ctx, conn, stackID, changeSetName)
+
+	if err != nil {
+		return false, fmt.Errorf("reading change set (%s) changes: %w", changeSetName, err)
+	}
+
+	for _, v := range changes {
+		if rc := v.ResourceChange; rc != nil {
+			log.Printf("[INFO] CloudFormation Stack (%s) change set (%s): %s %s (%s), replacement: %s", stackID, changeSetName, rc.Action, aws.ToString(rc.LogicalResourceId), aws.ToString(rc.ResourceType), rc.Replacement)
+		}
+	}
+
+	_, err = conn.ExecuteChangeSet(ctx, &cloudformation.ExecuteChangeSetInput{
+		ChangeSetName:      aws.String(changeSetName),
+		ClientRequestToken: input.ClientRequestToken,
+		StackName:          aws.String(stackID),
+	})
+
+	if err != nil {
+		return false, fmt.Errorf("executing change set (%s): %w", changeSetName, err)
+	}
+
+	return true, nil
+}
+
+func isEmptyChangeSetReason(reason string) bool {
+	return strings.Contains(reason, "didn't contain changes") || strings.Contains(reason, "No updates are to be performed")
+}
+
 func findStackByName(ctx context.Context, conn *cloudformation.Client, name string) (*awstypes.Stack, error) {
 	input := &cloudformation.DescribeStacksInput{
 		StackName: aws.String(name),
@@ -679,6 +840,10 @@ func stackHasActualChanges(ctx context.Context, d *schema.ResourceDiff, meta any
 		if attr.Computed && !attr.Optional {
 			continue
 		}
+		// Switching update mode doesn't change the stack.
+		if k == "use_change_set" {
+			continue
+		}
 
 		if d.HasChange(k) {
 			if attr.StateFunc == nil {
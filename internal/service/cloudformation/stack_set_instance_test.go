@@ -363,7 +363,6 @@ func TestAccCloudFormationStackSetInstance_concurrencyMode(t *testing.T) {
 // https://github.com/hashicorp/terraform-provider-aws/issues/32536.
 func TestAccCloudFormationStackSetInstance_delegatedAdministrator(t *testing.T) {
 	ctx := acctest.Context(t)
-	providers := make(map[string]*schema.Provider)
 	var stackInstanceSummaries []awstypes.StackInstanceSummary
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudformation_stack_set_instance.test"
@@ -372,24 +371,16 @@ func TestAccCloudFormationStackSetInstance_delegatedAdministrator(t *testing.T)
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheckStackSet(ctx, t)
-			acctest.PreCheckAlternateAccount(t)
 			acctest.PreCheckOrganizationMemberAccount(ctx, t)
 			acctest.PreCheckIAMServiceLinkedRole(ctx, t, "/aws-service-role/member.org.stacksets.cloudformation.amazonaws.com")
+			acctest.PreCheckAlternateAccountOrganizationManagementAccount(ctx, t)
+			acctest.PreCheckIAMServiceLinkedRoleWithProvider(ctx, t, func() *schema.Provider { return acctest.AlternateAccountProvider }, "/aws-service-role/stacksets.cloudformation.amazonaws.com")
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFormationEndpointID, "organizations"),
-		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesNamedAlternate(ctx, t, providers),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
 		CheckDestroy:             testAccCheckStackSetInstanceForOrganizationalUnitDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				// Run a simple configuration to initialize the alternate providers
-				Config: testAccStackSetConfig_delegatedAdministratorInit,
-			},
-			{
-				PreConfig: func() {
-					// Can only run check here because the provider is not available until the previous step.
-					acctest.PreCheckOrganizationManagementAccountWithProvider(ctx, t, acctest.NamedProviderFunc(acctest.ProviderNameAlternate, providers))
-					acctest.PreCheckIAMServiceLinkedRoleWithProvider(ctx, t, acctest.NamedProviderFunc(acctest.ProviderNameAlternate, providers), "/aws-service-role/stacksets.cloudformation.amazonaws.com")
-				},
 				Config: testAccStackSetInstanceConfig_delegatedAdministrator(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckStackSetInstanceForOrganizationalUnitExists(ctx, resourceName, stackInstanceSummaries),
@@ -13,7 +13,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -836,7 +835,6 @@ func TestAccCloudFormationStackSet_autoDeploymentDisabled(t *testing.T) {
 // Authenticate with member account as target account and management account as alternate.
 func TestAccCloudFormationStackSet_delegatedAdministrator(t *testing.T) {
 	ctx := acctest.Context(t)
-	providers := make(map[string]*schema.Provider)
 	var stackSet awstypes.StackSet
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudformation_stack_set.test"
@@ -845,22 +843,14 @@ func TestAccCloudFormationStackSet_delegatedAdministrator(t *testing.T) {
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
 			testAccPreCheckStackSet(ctx, t)
-			acctest.PreCheckAlternateAccount(t)
 			acctest.PreCheckOrganizationMemberAccount(ctx, t)
+			acctest.PreCheckAlternateAccountOrganizationManagementAccount(ctx, t)
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFormationServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesNamedAlternate(ctx, t, providers),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
 		CheckDestroy:             testAccCheckStackSetDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				// Run a simple configuration to initialize the alternate providers.
-				Config: testAccStackSetConfig_delegatedAdministratorInit,
-			},
-			{
-				PreConfig: func() {
-					// Can only run check here because the provider is not available until the previous step.
-					acctest.PreCheckOrganizationManagementAccountWithProvider(ctx, t, acctest.NamedProviderFunc(acctest.ProviderNameAlternate, providers))
-				},
 				Config: testAccStackSetConfig_delegatedAdministrator(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckStackSetExists(ctx, resourceName, &stackSet),
@@ -1484,15 +1474,6 @@ TEMPLATE
 `, rName, testAccStackSetTemplateBodyVPC(rName), enabled, retainStacksOnAccountRemoval)
 }
 
-// Initialize all the providers used by delegated administrator acceptance tests.
-var testAccStackSetConfig_delegatedAdministratorInit = acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), `
-data "aws_caller_identity" "member" {}
-
-data "aws_caller_identity" "management" {
-  provider = awsalternate
-}
-`)
-
 // Primary provider is Organizations member account that is made a delegated administrator.
 // Alternate provider is the Organizations management account.
 var testAccStackSetConfigDelegatedAdministratorConfig_base = acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), `
@@ -325,6 +325,88 @@ func TestAccCloudFormationStack_withParams(t *testing.T) {
 	})
 }
 
+func TestAccCloudFormationStack_useChangeSet(t *testing.T) {
+	ctx := acctest.Context(t)
+	var stack awstypes.Stack
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudformation_stack.test"
+
+	vpcCidrInitial := "10.0.0.0/16"
+	vpcCidrUpdated := "12.0.0.0/16"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStackDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackConfig_useChangeSet(rName, vpcCidrInitial),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStackExists(ctx, resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "parameters.VpcCIDR", vpcCidrInitial),
+					resource.TestCheckResourceAttr(resourceName, "use_change_set", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccStackConfig_useChangeSet(rName, vpcCidrUpdated),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStackExists(ctx, resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "parameters.VpcCIDR", vpcCidrUpdated),
+					resource.TestCheckResourceAttr(resourceName, "use_change_set", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFormationStack_rollbackConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var stack awstypes.Stack
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudformation_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStackDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackConfig_rollbackConfiguration(rName, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStackExists(ctx, resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "rollback_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "rollback_configuration.0.monitoring_time_in_minutes", "5"),
+					resource.TestCheckResourceAttr(resourceName, "rollback_configuration.0.rollback_trigger.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "rollback_configuration.0.rollback_trigger.*.arn", "aws_cloudwatch_metric_alarm.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"on_failure", names.AttrParameters},
+			},
+			{
+				Config: testAccStackConfig_rollbackConfiguration(rName, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStackExists(ctx, resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "rollback_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "rollback_configuration.0.monitoring_time_in_minutes", "10"),
+				),
+			},
+			{
+				Config: testAccStackConfig_params(rName, "12.0.0.0/16"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStackExists(ctx, resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "rollback_configuration.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
 // Regression for https://github.com/hashicorp/terraform/issues/4534
 func TestAccCloudFormationStack_WithURL_withParams(t *testing.T) {
 	ctx := acctest.Context(t)
@@ -924,6 +1006,94 @@ STACK
 `, rName, cidr)
 }
 
+func testAccStackConfig_useChangeSet(rName, cidr string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack" "test" {
+  name = %[1]q
+  parameters = {
+    VpcCIDR = %[2]q
+  }
+  template_body = <<STACK
+{
+  "Parameters" : {
+    "VpcCIDR" : {
+      "Description" : "CIDR to be used for the VPC",
+      "Type" : "String"
+    }
+  },
+  "Resources" : {
+    "MyVPC": {
+      "Type" : "AWS::EC2::VPC",
+      "Properties" : {
+        "CidrBlock" : {"Ref": "VpcCIDR"},
+        "Tags" : [
+          {"Key": "Name", "Value": "Primary_CF_VPC"}
+        ]
+      }
+    }
+  }
+}
+STACK
+
+  use_change_set = true
+}
+`, rName, cidr)
+}
+
+func testAccStackConfig_rollbackConfiguration(rName string, monitoringTime int) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_metric_alarm" "test" {
+  alarm_name          = %[1]q
+  comparison_operator = "GreaterThanOrEqualToThreshold"
+  evaluation_periods  = 2
+  metric_name         = "CPUUtilization"
+  namespace           = "AWS/EC2"
+  period              = 120
+  statistic           = "Average"
+  threshold           = 80
+}
+
+resource "aws_cloudformation_stack" "test" {
+  name = %[1]q
+  parameters = {
+    VpcCIDR = "10.0.0.0/16"
+  }
+  template_body = <<STACK
+{
+  "Parameters" : {
+    "VpcCIDR" : {
+      "Description" : "CIDR to be used for the VPC",
+      "Type" : "String"
+    }
+  },
+  "Resources" : {
+    "MyVPC": {
+      "Type" : "AWS::EC2::VPC",
+      "Properties" : {
+        "CidrBlock" : {"Ref": "VpcCIDR"},
+        "Tags" : [
+          {"Key": "Name", "Value": "Primary_CF_VPC"}
+        ]
+      }
+    }
+  }
+}
+STACK
+
+  on_failure         = "DELETE"
+  timeout_in_minutes = 1
+
+  rollback_configuration {
+    monitoring_time_in_minutes = %[2]d
+
+    rollback_trigger {
+      arn = aws_cloudwatch_metric_alarm.test.arn
+    }
+  }
+}
+`, rName, monitoringTime)
+}
+
 func testAccStackConfig_baseTemplateURL(rName string) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
@@ -9,6 +9,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
@@ -274,6 +275,10 @@ func resourceDistribution() *schema.Resource {
 			"default_root_object": {
 				Type:     schema.TypeString,
 				Optional: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(0, 255),
+					validation.StringDoesNotMatch(regexache.MustCompile(`^/`), "must not begin with a slash"),
+				),
 			},
 			names.AttrDomainName: {
 				Type:     schema.TypeString,
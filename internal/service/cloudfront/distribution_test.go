@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/YakDriver/regexache"
@@ -567,6 +568,27 @@ func TestAccCloudFrontDistribution_Origin_connectionTimeout(t *testing.T) {
 	})
 }
 
+func TestAccCloudFrontDistribution_defaultRootObjectValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, "cloudfront") },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDistributionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDistributionConfig_defaultRootObject("/index.html"),
+				ExpectError: regexache.MustCompile(`must not begin with a slash`),
+			},
+			{
+				Config:      testAccDistributionConfig_defaultRootObject(strings.Repeat("a", 256)),
+				ExpectError: regexache.MustCompile(`expected length of default_root_object to be in the range`),
+			},
+		},
+	})
+}
+
 func TestAccCloudFrontDistribution_Origin_originShield(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -3986,6 +4008,52 @@ resource "aws_cloudfront_distribution" "test" {
 `, item))
 }
 
+func testAccDistributionConfig_defaultRootObject(defaultRootObject string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_distribution" "test" {
+  enabled             = false
+  default_root_object = %[1]q
+
+  origin {
+    domain_name = "www.example.com"
+    origin_id   = "myOrigin"
+
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "https-only"
+      origin_ssl_protocols   = ["TLSv1.2"]
+    }
+  }
+
+  default_cache_behavior {
+    allowed_methods        = ["GET", "HEAD"]
+    cached_methods         = ["GET", "HEAD"]
+    target_origin_id       = "myOrigin"
+    viewer_protocol_policy = "allow-all"
+
+    forwarded_values {
+      query_string = false
+
+      cookies {
+        forward = "none"
+      }
+    }
+  }
+
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+}
+`, defaultRootObject)
+}
+
 func testAccDistributionConfig_eTagInitial(rName string) string {
 	return acctest.ConfigCompose(
 		logBucket(rName),
@@ -0,0 +1,305 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudhsmv2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudhsmv2"
+	"github.com/aws/aws-sdk-go-v2/service/cloudhsmv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	backupCopyResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_cloudhsm_v2_backup_copy", name="Backup Copy")
+func resourceBackupCopy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBackupCopyCreate,
+		ReadWithoutTimeout:   resourceBackupCopyRead,
+		DeleteWithoutTimeout: resourceBackupCopyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backup_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"backup_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"destination_region": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidRegionName,
+			},
+			"source_backup_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_cluster_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBackupCopyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudHSMV2Client(ctx)
+
+	sourceBackupID := d.Get("source_backup_id").(string)
+	destinationRegion := d.Get("destination_region").(string)
+	input := &cloudhsmv2.CopyBackupToRegionInput{
+		BackupId:          aws.String(sourceBackupID),
+		DestinationRegion: aws.String(destinationRegion),
+	}
+
+	_, err := conn.CopyBackupToRegion(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "copying CloudHSMv2 Backup (%s) to %s: %s", sourceBackupID, destinationRegion, err)
+	}
+
+	optFn := func(o *cloudhsmv2.Options) {
+		o.Region = destinationRegion
+	}
+
+	backup, err := waitBackupCopyReady(ctx, conn, sourceBackupID, d.Timeout(schema.TimeoutCreate), optFn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for CloudHSMv2 Backup (%s) copy to %s: %s", sourceBackupID, destinationRegion, err)
+	}
+
+	id, err := flex.FlattenResourceId([]string{destinationRegion, aws.ToString(backup.BackupId)}, backupCopyResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceBackupCopyRead(ctx, d, meta)...)
+}
+
+func resourceBackupCopyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudHSMV2Client(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), backupCopyResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	destinationRegion, backupID := parts[0], parts[1]
+	optFn := func(o *cloudhsmv2.Options) {
+		o.Region = destinationRegion
+	}
+
+	backup, err := findBackupByID(ctx, conn, backupID, optFn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudHSMv2 Backup (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudHSMv2 Backup (%s): %s", d.Id(), err)
+	}
+
+	d.Set("backup_id", backup.BackupId)
+	d.Set("backup_state", backup.BackupState)
+	d.Set("destination_region", destinationRegion)
+	d.Set("source_backup_id", backup.SourceBackup)
+	d.Set("source_cluster_id", backup.SourceCluster)
+	d.Set("source_region", backup.SourceRegion)
+
+	return diags
+}
+
+func resourceBackupCopyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudHSMV2Client(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), backupCopyResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	destinationRegion, backupID := parts[0], parts[1]
+	optFn := func(o *cloudhsmv2.Options) {
+		o.Region = destinationRegion
+	}
+
+	log.Printf("[INFO] Deleting CloudHSMv2 Backup: %s", d.Id())
+	_, err = conn.DeleteBackup(ctx, &cloudhsmv2.DeleteBackupInput{
+		BackupId: aws.String(backupID),
+	}, optFn)
+
+	if errs.IsA[*types.CloudHsmResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CloudHSMv2 Backup (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findBackupByID(ctx context.Context, conn *cloudhsmv2.Client, id string, optFns ...func(*cloudhsmv2.Options)) (*types.Backup, error) {
+	input := &cloudhsmv2.DescribeBackupsInput{
+		Filters: map[string][]string{
+			"backupIds": {id},
+		},
+	}
+
+	output, err := findBackup(ctx, conn, input, optFns...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Backups pending deletion are retained for 7 days but are no longer usable.
+	if state := output.BackupState; state == types.BackupStateDeleted || state == types.BackupStatePendingDeletion {
+		return nil, &retry.NotFoundError{
+			Message:     string(state),
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.ToString(output.BackupId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findBackupCopyBySourceBackupID(ctx context.Context, conn *cloudhsmv2.Client, sourceBackupID string, optFns ...func(*cloudhsmv2.Options)) (*types.Backup, error) {
+	input := &cloudhsmv2.DescribeBackupsInput{
+		Filters: map[string][]string{
+			"sourceBackupIds": {sourceBackupID},
+		},
+	}
+
+	output, err := findBackups(ctx, conn, input, optFns...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range output {
+		if state := v.BackupState; state == types.BackupStateDeleted || state == types.BackupStatePendingDeletion {
+			continue
+		}
+
+		return &v, nil
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: input,
+	}
+}
+
+func findBackup(ctx context.Context, conn *cloudhsmv2.Client, input *cloudhsmv2.DescribeBackupsInput, optFns ...func(*cloudhsmv2.Options)) (*types.Backup, error) {
+	output, err := findBackups(ctx, conn, input, optFns...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findBackups(ctx context.Context, conn *cloudhsmv2.Client, input *cloudhsmv2.DescribeBackupsInput, optFns ...func(*cloudhsmv2.Options)) ([]types.Backup, error) {
+	var output []types.Backup
+
+	pages := cloudhsmv2.NewDescribeBackupsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx, optFns...)
+
+		if errs.IsA[*types.CloudHsmResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Backups...)
+	}
+
+	return output, nil
+}
+
+func statusBackupCopy(ctx context.Context, conn *cloudhsmv2.Client, sourceBackupID string, optFns ...func(*cloudhsmv2.Options)) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findBackupCopyBySourceBackupID(ctx, conn, sourceBackupID, optFns...)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.BackupState), nil
+	}
+}
+
+func waitBackupCopyReady(ctx context.Context, conn *cloudhsmv2.Client, sourceBackupID string, timeout time.Duration, optFns ...func(*cloudhsmv2.Options)) (*types.Backup, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:        enum.Slice(types.BackupStateCreateInProgress),
+		Target:         enum.Slice(types.BackupStateReady),
+		Refresh:        statusBackupCopy(ctx, conn, sourceBackupID, optFns...),
+		Timeout:        timeout,
+		MinTimeout:     30 * time.Second,
+		NotFoundChecks: 20,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.Backup); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudhsmv2_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudhsmv2"
+	"github.com/aws/aws-sdk-go-v2/service/cloudhsmv2/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfcloudhsmv2 "github.com/hashicorp/terraform-provider-aws/internal/service/cloudhsmv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccBackupCopy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "CLOUDHSMV2_BACKUP_ID"
+	backupID := os.Getenv(key)
+	if backupID == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	resourceName := "aws_cloudhsm_v2_backup_copy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudHSMV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBackupCopyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBackupCopyConfig_basic(backupID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBackupCopyExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "backup_id"),
+					resource.TestCheckResourceAttr(resourceName, "backup_state", string(types.BackupStateReady)),
+					resource.TestCheckResourceAttr(resourceName, "destination_region", acctest.AlternateRegion()),
+					resource.TestCheckResourceAttr(resourceName, "source_backup_id", backupID),
+					resource.TestCheckResourceAttrSet(resourceName, "source_cluster_id"),
+					resource.TestCheckResourceAttr(resourceName, "source_region", acctest.Region()),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccBackupCopy_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "CLOUDHSMV2_BACKUP_ID"
+	backupID := os.Getenv(key)
+	if backupID == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	resourceName := "aws_cloudhsm_v2_backup_copy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudHSMV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBackupCopyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBackupCopyConfig_basic(backupID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBackupCopyExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcloudhsmv2.ResourceBackupCopy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBackupCopyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudHSMV2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cloudhsm_v2_backup_copy" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfcloudhsmv2.FindBackupByID(ctx, conn, parts[1], func(o *cloudhsmv2.Options) {
+				o.Region = parts[0]
+			})
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CloudHSMv2 Backup %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBackupCopyExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudHSMV2Client(ctx)
+
+		_, err = tfcloudhsmv2.FindBackupByID(ctx, conn, parts[1], func(o *cloudhsmv2.Options) {
+			o.Region = parts[0]
+		})
+
+		return err
+	}
+}
+
+func testAccBackupCopyConfig_basic(backupID string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudhsm_v2_backup_copy" "test" {
+  source_backup_id   = %[1]q
+  destination_region = %[2]q
+}
+`, backupID, acctest.AlternateRegion())
+}
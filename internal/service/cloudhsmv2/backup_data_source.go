@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudhsmv2
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudhsmv2"
+	"github.com/aws/aws-sdk-go-v2/service/cloudhsmv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_cloudhsm_v2_backup", name="Backup")
+func dataSourceBackup() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceBackupRead,
+
+		Schema: map[string]*schema.Schema{
+			"backup_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"backup_id", "cluster_id"},
+			},
+			"backup_state": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[types.BackupState](),
+			},
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"backup_id", "cluster_id"},
+			},
+			"copy_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hsm_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrMode: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"never_expires": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"source_backup": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_cluster": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceBackupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudHSMV2Client(ctx)
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	input := &cloudhsmv2.DescribeBackupsInput{
+		Filters: map[string][]string{},
+	}
+	if v, ok := d.GetOk("backup_id"); ok {
+		input.Filters["backupIds"] = []string{v.(string)}
+	}
+	if v, ok := d.GetOk("backup_state"); ok {
+		input.Filters["states"] = []string{v.(string)}
+	}
+	if v, ok := d.GetOk("cluster_id"); ok {
+		input.Filters["clusterIds"] = []string{v.(string)}
+	}
+
+	backups, err := findBackups(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudHSMv2 Backups: %s", err)
+	}
+
+	if len(backups) == 0 {
+		return sdkdiag.AppendFromErr(diags, tfresource.NewEmptyResultError(input))
+	}
+
+	// Return the most recent backup.
+	sort.Slice(backups, func(i, j int) bool {
+		return aws.ToTime(backups[i].CreateTimestamp).After(aws.ToTime(backups[j].CreateTimestamp))
+	})
+	backup := backups[0]
+
+	d.SetId(aws.ToString(backup.BackupId))
+	d.Set("backup_id", backup.BackupId)
+	d.Set("backup_state", backup.BackupState)
+	d.Set("cluster_id", backup.ClusterId)
+	if v := backup.CopyTimestamp; v != nil {
+		d.Set("copy_timestamp", aws.ToTime(v).Format(time.RFC3339))
+	} else {
+		d.Set("copy_timestamp", nil)
+	}
+	if v := backup.CreateTimestamp; v != nil {
+		d.Set("create_timestamp", aws.ToTime(v).Format(time.RFC3339))
+	} else {
+		d.Set("create_timestamp", nil)
+	}
+	d.Set("hsm_type", backup.HsmType)
+	d.Set(names.AttrMode, backup.Mode)
+	d.Set("never_expires", backup.NeverExpires)
+	d.Set("source_backup", backup.SourceBackup)
+	d.Set("source_cluster", backup.SourceCluster)
+	d.Set("source_region", backup.SourceRegion)
+
+	if err := d.Set(names.AttrTags, KeyValueTags(ctx, backup.TagList).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	return diags
+}
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudhsmv2_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudhsmv2/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccDataSourceBackup_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "CLOUDHSMV2_BACKUP_ID"
+	backupID := os.Getenv(key)
+	if backupID == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	dataSourceName := "data.aws_cloudhsm_v2_backup.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudHSMV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBackupDataSourceConfig_basic(backupID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "backup_id", backupID),
+					resource.TestCheckResourceAttr(dataSourceName, "backup_state", string(types.BackupStateReady)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "cluster_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "create_timestamp"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "hsm_type"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBackupDataSourceConfig_basic(backupID string) string {
+	return fmt.Sprintf(`
+data "aws_cloudhsm_v2_backup" "test" {
+  backup_id = %[1]q
+}
+`, backupID)
+}
@@ -13,11 +13,16 @@ func TestAccCloudHSMV2_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]map[string]func(t *testing.T){
+		"BackupCopy": {
+			acctest.CtBasic:      testAccBackupCopy_basic,
+			acctest.CtDisappears: testAccBackupCopy_disappears,
+		},
 		"Cluster": {
-			acctest.CtBasic:      testAccCluster_basic,
-			acctest.CtDisappears: testAccCluster_disappears,
-			"tags":               testAccCluster_tags,
-			"hsmType":            testAccCluster_hsmType,
+			acctest.CtBasic:         testAccCluster_basic,
+			acctest.CtDisappears:    testAccCluster_disappears,
+			"tags":                  testAccCluster_tags,
+			"hsmType":               testAccCluster_hsmType,
+			"backupRetentionPolicy": testAccCluster_backupRetentionPolicy,
 		},
 		"Hsm": {
 			"availabilityZone":   testAccHSM_AvailabilityZone,
@@ -27,6 +32,7 @@ func TestAccCloudHSMV2_serial(t *testing.T) {
 		},
 		"DataSource": {
 			acctest.CtBasic: testAccDataSourceCluster_basic,
+			"backup":        testAccDataSourceBackup_basic,
 		},
 	}
 
@@ -156,6 +156,46 @@ func testAccCluster_hsmType(t *testing.T) {
 	})
 }
 
+func testAccCluster_backupRetentionPolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_cloudhsm_v2_cluster.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudHSMV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_backupRetentionPolicy(rName, 7),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "backup_policy", string(types.BackupPolicyDefault)),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_policy.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_policy.0.type", string(types.BackupRetentionTypeDays)),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_policy.0.value", "7"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cluster_certificates"},
+			},
+			{
+				Config: testAccClusterConfig_backupRetentionPolicy(rName, 90),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_policy.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_policy.0.type", string(types.BackupRetentionTypeDays)),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_policy.0.value", "90"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckClusterDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudHSMV2Client(ctx)
@@ -246,3 +286,17 @@ resource "aws_cloudhsm_v2_cluster" "test" {
 }
 `, tagKey1, tagValue1, tagKey2, tagValue2))
 }
+
+func testAccClusterConfig_backupRetentionPolicy(rName string, days int) string {
+	return acctest.ConfigCompose(testAccClusterConfig_base(rName), fmt.Sprintf(`
+resource "aws_cloudhsm_v2_cluster" "test" {
+  hsm_type   = "hsm1.medium"
+  subnet_ids = aws_subnet.test[*].id
+
+  backup_retention_policy {
+    type  = "DAYS"
+    value = %[1]d
+  }
+}
+`, days))
+}
@@ -5,9 +5,11 @@ package cloudhsmv2
 
 // Exports for use in tests only.
 var (
-	ResourceCluster = resourceCluster
-	ResourceHSM     = resourceHSM
+	ResourceBackupCopy = resourceBackupCopy
+	ResourceCluster    = resourceCluster
+	ResourceHSM        = resourceHSM
 
+	FindBackupByID      = findBackupByID
 	FindClusterByID     = findClusterByID
 	FindHSMByTwoPartKey = findHSMByTwoPartKey
 )
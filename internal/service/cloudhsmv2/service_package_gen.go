@@ -22,6 +22,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceBackup,
+			TypeName: "aws_cloudhsm_v2_backup",
+			Name:     "Backup",
+		},
 		{
 			Factory:  dataSourceCluster,
 			TypeName: "aws_cloudhsm_v2_cluster",
@@ -32,6 +37,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceBackupCopy,
+			TypeName: "aws_cloudhsm_v2_backup_copy",
+			Name:     "Backup Copy",
+		},
 		{
 			Factory:  resourceCluster,
 			TypeName: "aws_cloudhsm_v2_cluster",
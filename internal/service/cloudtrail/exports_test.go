@@ -5,10 +5,12 @@ package cloudtrail
 
 // Exports for use in tests only.
 var (
+	ResourceChannel                           = resourceChannel
 	ResourceEventDataStore                    = resourceEventDataStore
 	ResourceOrganizationDelegatedAdminAccount = newOrganizationDelegatedAdminAccountResource
 	ResourceTrail                             = resourceTrail
 
+	FindChannelByARN           = findChannelByARN
 	FindEventDataStoreByARN    = findEventDataStoreByARN
 	FindTrailByARN             = findTrailByARN
 	ServiceAccountPerRegionMap = serviceAccountPerRegionMap
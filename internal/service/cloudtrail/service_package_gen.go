@@ -47,6 +47,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  resourceChannel,
+			TypeName: "aws_cloudtrail_channel",
+			Name:     "Channel",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrID,
+			},
+		},
 		{
 			Factory:  resourceEventDataStore,
 			TypeName: "aws_cloudtrail_event_data_store",
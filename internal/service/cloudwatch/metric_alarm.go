@@ -6,6 +6,7 @@ package cloudwatch
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 
 	"github.com/YakDriver/regexache"
@@ -146,7 +147,7 @@ func resourceMetricAlarm() *schema.Resource {
 						names.AttrExpression: {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: validation.StringLenBetween(1, 1024),
+							ValidateFunc: validation.StringLenBetween(1, 2048),
 						},
 						names.AttrID: {
 							Type:         schema.TypeString,
@@ -313,6 +314,15 @@ func resourceMetricAlarm() *schema.Resource {
 					}
 				}
 
+				if v, ok := diff.GetOk("comparison_operator"); ok && diff.NewValueKnown("threshold_metric_id") {
+					switch operator := types.ComparisonOperator(v.(string)); operator {
+					case types.ComparisonOperatorLessThanLowerOrGreaterThanUpperThreshold, types.ComparisonOperatorLessThanLowerThreshold, types.ComparisonOperatorGreaterThanUpperThreshold:
+						if _, ok := diff.GetOk("threshold_metric_id"); !ok {
+							return fmt.Errorf("`threshold_metric_id` must be set when `comparison_operator` is %s", operator)
+						}
+					}
+				}
+
 				return nil
 			},
 		),
@@ -563,6 +563,24 @@ func TestAccCloudWatchMetricAlarm_metricQuery(t *testing.T) {
 	})
 }
 
+func TestAccCloudWatchMetricAlarm_anomalyDetectionMissingThresholdMetricID(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMetricAlarmDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMetricAlarmConfig_anomalyDetectionMissingThresholdMetricID(rName),
+				ExpectError: regexache.MustCompile("`threshold_metric_id` must be set when `comparison_operator` is GreaterThanUpperThreshold"),
+			},
+		},
+	})
+}
+
 func TestAccCloudWatchMetricAlarm_missingStatistic(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -971,6 +989,35 @@ resource "aws_cloudwatch_metric_alarm" "test" {
 `, rName)
 }
 
+func testAccMetricAlarmConfig_anomalyDetectionMissingThresholdMetricID(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_metric_alarm" "test" {
+  alarm_name          = %[1]q
+  comparison_operator = "GreaterThanUpperThreshold"
+  evaluation_periods  = 2
+  threshold           = 80
+
+  metric_query {
+    id          = "e1"
+    expression  = "ANOMALY_DETECTION_BAND(m1)"
+    return_data = true
+  }
+
+  metric_query {
+    id          = "m1"
+    return_data = true
+
+    metric {
+      metric_name = "CPUUtilization"
+      namespace   = "AWS/EC2"
+      period      = 120
+      stat        = "Average"
+    }
+  }
+}
+`, rName)
+}
+
 func testAccMetricAlarmConfig_metricQueryExpressionReferenceUpdated(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudwatch_metric_alarm" "test" {
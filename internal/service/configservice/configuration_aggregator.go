@@ -146,14 +146,18 @@ func resourceConfigurationAggregatorPut(ctx context.Context, d *schema.ResourceD
 			input.OrganizationAggregationSource = expandOrganizationAggregationSource(v.([]interface{})[0].(map[string]interface{}))
 		}
 
-		output, err := conn.PutConfigurationAggregator(ctx, input)
+		// Organization aggregators require the aggregator IAM role and AWS Config trusted access
+		// in AWS Organizations, both of which are often created in the same configuration.
+		outputRaw, err := tfresource.RetryWhenIsOneOf2[*types.InvalidRoleException, *types.OrganizationAccessDeniedException](ctx, propagationTimeout, func() (interface{}, error) {
+			return conn.PutConfigurationAggregator(ctx, input)
+		})
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "putting ConfigService Configuration Aggregator (%s): %s", name, err)
 		}
 
 		if d.IsNewResource() {
-			d.SetId(aws.ToString(output.ConfigurationAggregator.ConfigurationAggregatorName))
+			d.SetId(aws.ToString(outputRaw.(*configservice.PutConfigurationAggregatorOutput).ConfigurationAggregator.ConfigurationAggregatorName))
 		}
 	}
 
@@ -24,6 +24,7 @@ func TestAccCUR_serial(t *testing.T) {
 			"athena":                testAccReportDefinition_athena,
 			"refresh":               testAccReportDefinition_refresh,
 			"overwrite":             testAccReportDefinition_overwrite,
+			"updateVersioning":      testAccReportDefinition_updateVersioning,
 			"DataSource_basic":      testAccReportDefinitionDataSource_basic,
 			"DataSource_additional": testAccReportDefinitionDataSource_additional,
 		},
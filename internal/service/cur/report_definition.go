@@ -15,6 +15,7 @@ import (
 	cur "github.com/aws/aws-sdk-go-v2/service/costandusagereportservice"
 	"github.com/aws/aws-sdk-go-v2/service/costandusagereportservice/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -89,7 +90,6 @@ func resourceReportDefinition() *schema.Resource {
 			},
 			"report_versioning": {
 				Type:             schema.TypeString,
-				ForceNew:         true,
 				Optional:         true,
 				Default:          types.ReportVersioningCreateNewReport,
 				ValidateDiagFunc: enum.Validate[types.ReportVersioning](),
@@ -118,7 +118,10 @@ func resourceReportDefinition() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceReportDefinitionCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -127,33 +130,17 @@ func resourceReportDefinitionCreate(ctx context.Context, d *schema.ResourceData,
 	conn := meta.(*conns.AWSClient).CURClient(ctx)
 
 	reportName := d.Get("report_name").(string)
-	additionalArtifacts := flex.ExpandStringyValueSet[types.AdditionalArtifact](d.Get("additional_artifacts").(*schema.Set))
-	compression := types.CompressionFormat(d.Get("compression").(string))
-	format := types.ReportFormat(d.Get(names.AttrFormat).(string))
-	prefix := d.Get("s3_prefix").(string)
-	reportVersioning := types.ReportVersioning(d.Get("report_versioning").(string))
-
-	if err := checkReportDefinitionPropertyCombination(
-		additionalArtifacts,
-		compression,
-		format,
-		prefix,
-		reportVersioning,
-	); err != nil {
-		return sdkdiag.AppendFromErr(diags, err)
-	}
-
 	input := &cur.PutReportDefinitionInput{
 		ReportDefinition: &types.ReportDefinition{
-			AdditionalArtifacts:      additionalArtifacts,
+			AdditionalArtifacts:      flex.ExpandStringyValueSet[types.AdditionalArtifact](d.Get("additional_artifacts").(*schema.Set)),
 			AdditionalSchemaElements: flex.ExpandStringyValueSet[types.SchemaElement](d.Get("additional_schema_elements").(*schema.Set)),
-			Compression:              compression,
-			Format:                   format,
+			Compression:              types.CompressionFormat(d.Get("compression").(string)),
+			Format:                   types.ReportFormat(d.Get(names.AttrFormat).(string)),
 			RefreshClosedReports:     aws.Bool(d.Get("refresh_closed_reports").(bool)),
 			ReportName:               aws.String(reportName),
-			ReportVersioning:         reportVersioning,
+			ReportVersioning:         types.ReportVersioning(d.Get("report_versioning").(string)),
 			S3Bucket:                 aws.String(d.Get(names.AttrS3Bucket).(string)),
-			S3Prefix:                 aws.String(prefix),
+			S3Prefix:                 aws.String(d.Get("s3_prefix").(string)),
 			S3Region:                 types.AWSRegion(d.Get("s3_region").(string)),
 			TimeUnit:                 types.TimeUnit(d.Get("time_unit").(string)),
 		},
@@ -217,33 +204,17 @@ func resourceReportDefinitionUpdate(ctx context.Context, d *schema.ResourceData,
 	conn := meta.(*conns.AWSClient).CURClient(ctx)
 
 	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
-		additionalArtifacts := flex.ExpandStringyValueSet[types.AdditionalArtifact](d.Get("additional_artifacts").(*schema.Set))
-		compression := types.CompressionFormat(d.Get("compression").(string))
-		format := types.ReportFormat(d.Get(names.AttrFormat).(string))
-		prefix := d.Get("s3_prefix").(string)
-		reportVersioning := types.ReportVersioning(d.Get("report_versioning").(string))
-
-		if err := checkReportDefinitionPropertyCombination(
-			additionalArtifacts,
-			compression,
-			format,
-			prefix,
-			reportVersioning,
-		); err != nil {
-			return sdkdiag.AppendFromErr(diags, err)
-		}
-
 		input := &cur.ModifyReportDefinitionInput{
 			ReportDefinition: &types.ReportDefinition{
-				AdditionalArtifacts:      additionalArtifacts,
+				AdditionalArtifacts:      flex.ExpandStringyValueSet[types.AdditionalArtifact](d.Get("additional_artifacts").(*schema.Set)),
 				AdditionalSchemaElements: flex.ExpandStringyValueSet[types.SchemaElement](d.Get("additional_schema_elements").(*schema.Set)),
-				Compression:              compression,
-				Format:                   format,
+				Compression:              types.CompressionFormat(d.Get("compression").(string)),
+				Format:                   types.ReportFormat(d.Get(names.AttrFormat).(string)),
 				RefreshClosedReports:     aws.Bool(d.Get("refresh_closed_reports").(bool)),
 				ReportName:               aws.String(d.Id()),
-				ReportVersioning:         reportVersioning,
+				ReportVersioning:         types.ReportVersioning(d.Get("report_versioning").(string)),
 				S3Bucket:                 aws.String(d.Get(names.AttrS3Bucket).(string)),
-				S3Prefix:                 aws.String(prefix),
+				S3Prefix:                 aws.String(d.Get("s3_prefix").(string)),
 				S3Region:                 types.AWSRegion(d.Get("s3_region").(string)),
 				TimeUnit:                 types.TimeUnit(d.Get("time_unit").(string)),
 			},
@@ -280,6 +251,22 @@ func resourceReportDefinitionDelete(ctx context.Context, d *schema.ResourceData,
 	return diags
 }
 
+func resourceReportDefinitionCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"additional_artifacts", "compression", names.AttrFormat, "s3_prefix", "report_versioning"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	return checkReportDefinitionPropertyCombination(
+		flex.ExpandStringyValueSet[types.AdditionalArtifact](d.Get("additional_artifacts").(*schema.Set)),
+		types.CompressionFormat(d.Get("compression").(string)),
+		types.ReportFormat(d.Get(names.AttrFormat).(string)),
+		d.Get("s3_prefix").(string),
+		types.ReportVersioning(d.Get("report_versioning").(string)),
+	)
+}
+
 func checkReportDefinitionPropertyCombination(additionalArtifacts []types.AdditionalArtifact, compression types.CompressionFormat, format types.ReportFormat, prefix string, reportVersioning types.ReportVersioning) error {
 	// perform various combination checks, AWS API unhelpfully just returns an empty ValidationException
 	// these combinations have been determined from the Create Report AWS Console Web Form
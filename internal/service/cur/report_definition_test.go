@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/costandusagereportservice/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -348,6 +349,47 @@ func testAccReportDefinition_overwrite(t *testing.T) {
 	})
 }
 
+func testAccReportDefinition_updateVersioning(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_cur_report_definition.test"
+	reportName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	bucketName := fmt.Sprintf("tf-test-bucket-%d", sdkacctest.RandInt())
+	bucketPrefix := "test"
+	format := "textORcsv"
+	compression := "GZIP"
+	additionalArtifacts := []string{"REDSHIFT", "QUICKSIGHT"}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CURServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReportDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReportDefinitionConfig_additional(reportName, bucketName, bucketPrefix, format, compression, additionalArtifacts, true, "CREATE_NEW_REPORT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReportDefinitionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "refresh_closed_reports", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "report_versioning", "CREATE_NEW_REPORT"),
+				),
+			},
+			{
+				Config: testAccReportDefinitionConfig_additional(reportName, bucketName, bucketPrefix, format, compression, additionalArtifacts, false, "OVERWRITE_REPORT"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReportDefinitionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "refresh_closed_reports", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "report_versioning", "OVERWRITE_REPORT"),
+				),
+			},
+		},
+	})
+}
+
 func testAccReportDefinition_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_cur_report_definition.test"
@@ -53,3 +53,51 @@ func FindRevisionById(ctx context.Context, conn *dataexchange.Client, dataSetId,
 
 	return output, nil
 }
+
+func findRevisionAssets(ctx context.Context, conn *dataexchange.Client, dataSetId, revisionId string) ([]awstypes.AssetEntry, error) {
+	input := &dataexchange.ListRevisionAssetsInput{
+		DataSetId:  aws.String(dataSetId),
+		RevisionId: aws.String(revisionId),
+	}
+	var output []awstypes.AssetEntry
+
+	pages := dataexchange.NewListRevisionAssetsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Assets...)
+	}
+
+	return output, nil
+}
+
+func findJobByID(ctx context.Context, conn *dataexchange.Client, id string) (*dataexchange.GetJobOutput, error) {
+	input := &dataexchange.GetJobInput{
+		JobId: aws.String(id),
+	}
+	output, err := conn.GetJob(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/dataexchange"
@@ -46,18 +47,66 @@ func ResourceRevision() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(0, 16348),
 			},
+			"asset": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"asset_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"data_set_id": {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
+			"finalized": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"revision_id": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"s3_asset_source": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrBucket: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						names.AttrKey: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
 		CustomizeDiff: verify.SetTagsDiff,
 	}
 }
@@ -79,6 +128,35 @@ func resourceRevisionCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 	d.SetId(fmt.Sprintf("%s:%s", aws.ToString(out.DataSetId), aws.ToString(out.Id)))
 
+	if v, ok := d.GetOk("s3_asset_source"); ok && v.(*schema.Set).Len() > 0 {
+		input := &dataexchange.CreateJobInput{
+			Details: &awstypes.RequestDetails{
+				ImportAssetsFromS3: &awstypes.ImportAssetsFromS3RequestDetails{
+					AssetSources: expandAssetSourceEntries(v.(*schema.Set).List()),
+					DataSetId:    out.DataSetId,
+					RevisionId:   out.Id,
+				},
+			},
+			Type: awstypes.TypeImportAssetsFromS3,
+		}
+
+		if err := runJob(ctx, conn, input, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "importing DataExchange Revision (%s) assets from S3: %s", d.Id(), err)
+		}
+	}
+
+	if d.Get("finalized").(bool) {
+		_, err := conn.UpdateRevision(ctx, &dataexchange.UpdateRevisionInput{
+			DataSetId:  out.DataSetId,
+			Finalized:  true,
+			RevisionId: out.Id,
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "finalizing DataExchange Revision (%s): %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceRevisionRead(ctx, d, meta)...)
 }
 
@@ -103,8 +181,18 @@ func resourceRevisionRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return sdkdiag.AppendErrorf(diags, "reading DataExchange Revision (%s): %s", d.Id(), err)
 	}
 
+	assets, err := findRevisionAssets(ctx, conn, dataSetId, revisionId)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DataExchange Revision (%s) assets: %s", d.Id(), err)
+	}
+
+	if err := d.Set("asset", flattenAssetEntries(assets)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting asset: %s", err)
+	}
 	d.Set("data_set_id", revision.DataSetId)
 	d.Set(names.AttrComment, revision.Comment)
+	d.Set("finalized", revision.Finalized)
 	d.Set(names.AttrARN, revision.Arn)
 	d.Set("revision_id", revision.Id)
 
@@ -127,6 +215,11 @@ func resourceRevisionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			input.Comment = aws.String(d.Get(names.AttrComment).(string))
 		}
 
+		// The API only accepts finalizing a revision. With this SDK version a finalized revision can't be un-finalized.
+		if d.HasChange("finalized") {
+			input.Finalized = d.Get("finalized").(bool)
+		}
+
 		log.Printf("[DEBUG] Updating DataExchange Revision: %s", d.Id())
 		_, err := conn.UpdateRevision(ctx, input)
 		if err != nil {
@@ -167,3 +260,59 @@ func RevisionParseResourceID(id string) (string, string, error) {
 
 	return "", "", fmt.Errorf("unexpected format for ID (%s), expected DATA-SET_ID:REVISION-ID", id)
 }
+
+func runJob(ctx context.Context, conn *dataexchange.Client, input *dataexchange.CreateJobInput, timeout time.Duration) error {
+	output, err := conn.CreateJob(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("creating job: %w", err)
+	}
+
+	jobID := aws.ToString(output.Id)
+
+	_, err = conn.StartJob(ctx, &dataexchange.StartJobInput{
+		JobId: aws.String(jobID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("starting job (%s): %w", jobID, err)
+	}
+
+	if _, err := waitJobCompleted(ctx, conn, jobID, timeout); err != nil {
+		return fmt.Errorf("waiting for job (%s) to complete: %w", jobID, err)
+	}
+
+	return nil
+}
+
+func expandAssetSourceEntries(tfList []interface{}) []awstypes.AssetSourceEntry {
+	var apiObjects []awstypes.AssetSourceEntry
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, awstypes.AssetSourceEntry{
+			Bucket: aws.String(tfMap[names.AttrBucket].(string)),
+			Key:    aws.String(tfMap[names.AttrKey].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenAssetEntries(apiObjects []awstypes.AssetEntry) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrARN:  aws.ToString(apiObject.Arn),
+			"asset_id":     aws.ToString(apiObject.Id),
+			names.AttrName: aws.ToString(apiObject.Name),
+		})
+	}
+
+	return tfList
+}
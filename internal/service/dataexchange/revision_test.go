@@ -96,6 +96,47 @@ func TestAccDataExchangeRevision_tags(t *testing.T) {
 	})
 }
 
+func TestAccDataExchangeRevision_s3AssetSource(t *testing.T) {
+	ctx := acctest.Context(t)
+	var proj dataexchange.GetRevisionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dataexchange_revision.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.DataExchangeEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataExchangeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRevisionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRevisionConfig_s3AssetSource(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRevisionExists(ctx, resourceName, &proj),
+					resource.TestCheckResourceAttr(resourceName, "asset.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "asset.0.name", "test.csv"),
+					resource.TestCheckResourceAttrSet(resourceName, "asset.0.asset_id"),
+					resource.TestCheckResourceAttr(resourceName, "finalized", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "s3_asset_source.#", acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"s3_asset_source"},
+			},
+			{
+				Config: testAccRevisionConfig_s3AssetSource(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRevisionExists(ctx, resourceName, &proj),
+					resource.TestCheckResourceAttr(resourceName, "asset.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "finalized", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDataExchangeRevision_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var proj dataexchange.GetRevisionOutput
@@ -259,3 +300,34 @@ resource "aws_dataexchange_revision" "test" {
 }
 `, rName, tagKey1, tagValue1, tagKey2, tagValue2)
 }
+
+func testAccRevisionConfig_s3AssetSource(rName string, finalized bool) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "test.csv"
+  content = "a,b,c"
+}
+
+resource "aws_dataexchange_data_set" "test" {
+  asset_type  = "S3_SNAPSHOT"
+  description = %[1]q
+  name        = %[1]q
+}
+
+resource "aws_dataexchange_revision" "test" {
+  data_set_id = aws_dataexchange_data_set.test.id
+  finalized   = %[2]t
+
+  s3_asset_source {
+    bucket = aws_s3_object.test.bucket
+    key    = aws_s3_object.test.key
+  }
+}
+`, rName, finalized)
+}
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dataexchange
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dataexchange"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dataexchange/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitJobCompleted(ctx context.Context, conn *dataexchange.Client, id string, timeout time.Duration) (*dataexchange.GetJobOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StateWaiting, awstypes.StateInProgress),
+		Target:  enum.Slice(awstypes.StateCompleted),
+		Refresh: statusJob(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*dataexchange.GetJobOutput); ok {
+		var errs []error
+		for _, v := range output.Errors {
+			errs = append(errs, errors.New(aws.ToString(v.Message)))
+		}
+		tfresource.SetLastError(err, errors.Join(errs...))
+
+		return output, err
+	}
+
+	return nil, err
+}
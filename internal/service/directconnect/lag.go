@@ -56,6 +56,11 @@ func resourceLag() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validConnectionBandWidth(),
 			},
+			"encryption_mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Optional: true,
+			},
 			names.AttrForceDestroy: {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -74,6 +79,10 @@ func resourceLag() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"macsec_capable": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			names.AttrName: {
 				Type:     schema.TypeString,
 				Required: true,
@@ -88,6 +97,12 @@ func resourceLag() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"request_macsec": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
@@ -105,6 +120,7 @@ func resourceLagCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 		ConnectionsBandwidth: aws.String(d.Get("connections_bandwidth").(string)),
 		LagName:              aws.String(name),
 		Location:             aws.String(d.Get(names.AttrLocation).(string)),
+		RequestMACSec:        aws.Bool(d.Get("request_macsec").(bool)),
 		Tags:                 getTagsIn(ctx),
 	}
 
@@ -164,12 +180,17 @@ func resourceLagRead(ctx context.Context, d *schema.ResourceData, meta interface
 	}.String()
 	d.Set(names.AttrARN, arn)
 	d.Set("connections_bandwidth", lag.ConnectionsBandwidth)
+	d.Set("encryption_mode", lag.EncryptionMode)
 	d.Set("has_logical_redundancy", lag.HasLogicalRedundancy)
 	d.Set("jumbo_frame_capable", lag.JumboFrameCapable)
 	d.Set(names.AttrLocation, lag.Location)
+	d.Set("macsec_capable", lag.MacSecCapable)
 	d.Set(names.AttrName, lag.LagName)
 	d.Set(names.AttrOwnerAccountID, lag.OwnerAccount)
 	d.Set(names.AttrProviderName, lag.ProviderName)
+	if !d.IsNewResource() && !d.Get("request_macsec").(bool) {
+		d.Set("request_macsec", aws.Bool(false))
+	}
 
 	return diags
 }
@@ -178,10 +199,17 @@ func resourceLagUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DirectConnectClient(ctx)
 
-	if d.HasChange(names.AttrName) {
+	if d.HasChanges("encryption_mode", names.AttrName) {
 		input := &directconnect.UpdateLagInput{
-			LagId:   aws.String(d.Id()),
-			LagName: aws.String(d.Get(names.AttrName).(string)),
+			LagId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("encryption_mode") {
+			input.EncryptionMode = aws.String(d.Get("encryption_mode").(string))
+		}
+
+		if d.HasChange(names.AttrName) {
+			input.LagName = aws.String(d.Get(names.AttrName).(string))
 		}
 
 		_, err := conn.UpdateLag(ctx, input)
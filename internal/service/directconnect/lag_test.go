@@ -180,6 +180,40 @@ func TestAccDirectConnectLag_providerName(t *testing.T) {
 	})
 }
 
+func TestAccDirectConnectLag_macsecRequested(t *testing.T) {
+	ctx := acctest.Context(t)
+	var lag awstypes.Lag
+	resourceName := "aws_dx_lag.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DirectConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLagDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLagConfig_macsecEnabled(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLagExists(ctx, resourceName, &lag),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "directconnect", regexache.MustCompile(`dxlag/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "connections_bandwidth", "100Gbps"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrLocation),
+					resource.TestCheckResourceAttrSet(resourceName, "macsec_capable"),
+					resource.TestCheckResourceAttr(resourceName, "request_macsec", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrForceDestroy, "request_macsec"},
+			},
+		},
+	})
+}
+
 func TestAccDirectConnectLag_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var lag awstypes.Lag
@@ -326,6 +360,30 @@ resource "aws_dx_lag" "test" {
 `, rName)
 }
 
+func testAccLagConfig_macsecEnabled(rName string) string {
+	return fmt.Sprintf(`
+data "aws_dx_locations" "test" {}
+
+locals {
+  location_codes = tolist(data.aws_dx_locations.test.location_codes)
+  idx            = min(2, length(local.location_codes) - 1)
+}
+
+data "aws_dx_location" "test" {
+  location_code = local.location_codes[local.idx]
+}
+
+resource "aws_dx_lag" "test" {
+  name                  = %[1]q
+  connections_bandwidth = "100Gbps"
+  location              = data.aws_dx_location.test.location_code
+  request_macsec        = true
+
+  provider_name = data.aws_dx_location.test.available_providers[0]
+}
+`, rName)
+}
+
 func testAccLagConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 data "aws_dx_locations" "test" {}
@@ -159,6 +159,20 @@ const (
 	replicationStatusReplicationStarting  = "replication_starting"
 )
 
+const (
+	replicationTaskAssessmentRunStatusCancelling        = "cancelling"
+	replicationTaskAssessmentRunStatusDeleting          = "deleting"
+	replicationTaskAssessmentRunStatusErrorExecuting    = "error-executing"
+	replicationTaskAssessmentRunStatusErrorProvisioning = "error-provisioning"
+	replicationTaskAssessmentRunStatusFailed            = "failed"
+	replicationTaskAssessmentRunStatusInvalidState      = "invalid state"
+	replicationTaskAssessmentRunStatusPassed            = "passed"
+	replicationTaskAssessmentRunStatusProvisioning      = "provisioning"
+	replicationTaskAssessmentRunStatusRunning           = "running"
+	replicationTaskAssessmentRunStatusStarting          = "starting"
+	replicationTaskAssessmentRunStatusWarning           = "warning"
+)
+
 const (
 	replicationTypeValueStartReplication = "creating"
 	replicationTypeValueResumeProcessing = "resume-processing"
@@ -0,0 +1,351 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dms
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	dms "github.com/aws/aws-sdk-go-v2/service/databasemigrationservice"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databasemigrationservice/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_dms_replication_task_assessment_run", name="Replication Task Assessment Run")
+func resourceReplicationTaskAssessmentRun() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceReplicationTaskAssessmentRunCreate,
+		ReadWithoutTimeout:   resourceReplicationTaskAssessmentRunRead,
+		DeleteWithoutTimeout: resourceReplicationTaskAssessmentRunDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"assessment_run_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexache.MustCompile(`^[A-Za-z][0-9A-Za-z-]*$`), "must start with a letter and contain only alphanumeric characters and hyphens"),
+				),
+			},
+			"exclude": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ForceNew:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"include_only"},
+			},
+			"include_only": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ForceNew:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"exclude"},
+			},
+			"last_failure_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"replication_task_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"result_encryption_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(encryptionMode_Values(), false),
+			},
+			"result_kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"result_location_bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"result_location_folder": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"service_access_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceReplicationTaskAssessmentRunCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DMSClient(ctx)
+
+	name := d.Get("assessment_run_name").(string)
+	input := &dms.StartReplicationTaskAssessmentRunInput{
+		AssessmentRunName:    aws.String(name),
+		ReplicationTaskArn:   aws.String(d.Get("replication_task_arn").(string)),
+		ResultLocationBucket: aws.String(d.Get("result_location_bucket").(string)),
+		ServiceAccessRoleArn: aws.String(d.Get("service_access_role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("exclude"); ok && v.(*schema.Set).Len() > 0 {
+		input.Exclude = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("include_only"); ok && v.(*schema.Set).Len() > 0 {
+		input.IncludeOnly = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("result_encryption_mode"); ok {
+		input.ResultEncryptionMode = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("result_kms_key_arn"); ok {
+		input.ResultKmsKeyArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("result_location_folder"); ok {
+		input.ResultLocationFolder = aws.String(v.(string))
+	}
+
+	output, err := conn.StartReplicationTaskAssessmentRun(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting DMS Replication Task Assessment Run (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.ReplicationTaskAssessmentRun.ReplicationTaskAssessmentRunArn))
+
+	if _, err := waitReplicationTaskAssessmentRunCompleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for DMS Replication Task Assessment Run (%s) complete: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceReplicationTaskAssessmentRunRead(ctx, d, meta)...)
+}
+
+func resourceReplicationTaskAssessmentRunRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DMSClient(ctx)
+
+	run, err := findReplicationTaskAssessmentRunByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DMS Replication Task Assessment Run (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DMS Replication Task Assessment Run (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, run.ReplicationTaskAssessmentRunArn)
+	d.Set("assessment_run_name", run.AssessmentRunName)
+	d.Set("last_failure_message", run.LastFailureMessage)
+	d.Set("replication_task_arn", run.ReplicationTaskArn)
+	d.Set("result_encryption_mode", run.ResultEncryptionMode)
+	d.Set("result_kms_key_arn", run.ResultKmsKeyArn)
+	d.Set("result_location_bucket", run.ResultLocationBucket)
+	d.Set("result_location_folder", run.ResultLocationFolder)
+	d.Set("service_access_role_arn", run.ServiceAccessRoleArn)
+	d.Set(names.AttrStatus, run.Status)
+
+	return diags
+}
+
+func resourceReplicationTaskAssessmentRunDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DMSClient(ctx)
+
+	switch d.Get(names.AttrStatus).(string) {
+	case replicationTaskAssessmentRunStatusProvisioning, replicationTaskAssessmentRunStatusRunning, replicationTaskAssessmentRunStatusStarting:
+		log.Printf("[DEBUG] Cancelling DMS Replication Task Assessment Run: %s", d.Id())
+		_, err := conn.CancelReplicationTaskAssessmentRun(ctx, &dms.CancelReplicationTaskAssessmentRunInput{
+			ReplicationTaskAssessmentRunArn: aws.String(d.Id()),
+		})
+
+		if err != nil && !errs.IsA[*awstypes.ResourceNotFoundFault](err) && !errs.IsA[*awstypes.InvalidResourceStateFault](err) {
+			return sdkdiag.AppendErrorf(diags, "cancelling DMS Replication Task Assessment Run (%s): %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting DMS Replication Task Assessment Run: %s", d.Id())
+	_, err := tfresource.RetryWhenIsA[*awstypes.InvalidResourceStateFault](ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeleteReplicationTaskAssessmentRun(ctx, &dms.DeleteReplicationTaskAssessmentRunInput{
+			ReplicationTaskAssessmentRunArn: aws.String(d.Id()),
+		})
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundFault](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting DMS Replication Task Assessment Run (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitReplicationTaskAssessmentRunDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for DMS Replication Task Assessment Run (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findReplicationTaskAssessmentRunByARN(ctx context.Context, conn *dms.Client, arn string) (*awstypes.ReplicationTaskAssessmentRun, error) {
+	input := &dms.DescribeReplicationTaskAssessmentRunsInput{
+		Filters: []awstypes.Filter{{
+			Name:   aws.String("replication-task-assessment-run-arn"),
+			Values: []string{arn},
+		}},
+	}
+
+	return findReplicationTaskAssessmentRun(ctx, conn, input)
+}
+
+func findReplicationTaskAssessmentRun(ctx context.Context, conn *dms.Client, input *dms.DescribeReplicationTaskAssessmentRunsInput) (*awstypes.ReplicationTaskAssessmentRun, error) {
+	output, err := findReplicationTaskAssessmentRuns(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findReplicationTaskAssessmentRuns(ctx context.Context, conn *dms.Client, input *dms.DescribeReplicationTaskAssessmentRunsInput) ([]awstypes.ReplicationTaskAssessmentRun, error) {
+	var output []awstypes.ReplicationTaskAssessmentRun
+
+	pages := dms.NewDescribeReplicationTaskAssessmentRunsPaginator(conn, input)
+
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundFault](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ReplicationTaskAssessmentRuns...)
+	}
+
+	return output, nil
+}
+
+func statusReplicationTaskAssessmentRun(ctx context.Context, conn *dms.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findReplicationTaskAssessmentRunByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.ToString(output.Status), nil
+	}
+}
+
+func waitReplicationTaskAssessmentRunCompleted(ctx context.Context, conn *dms.Client, arn string, timeout time.Duration) (*awstypes.ReplicationTaskAssessmentRun, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			replicationTaskAssessmentRunStatusProvisioning,
+			replicationTaskAssessmentRunStatusRunning,
+			replicationTaskAssessmentRunStatusStarting,
+		},
+		Target: []string{
+			replicationTaskAssessmentRunStatusFailed,
+			replicationTaskAssessmentRunStatusPassed,
+			replicationTaskAssessmentRunStatusWarning,
+		},
+		Refresh:    statusReplicationTaskAssessmentRun(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ReplicationTaskAssessmentRun); ok {
+		if v := aws.ToString(output.LastFailureMessage); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitReplicationTaskAssessmentRunDeleted(ctx context.Context, conn *dms.Client, arn string, timeout time.Duration) (*awstypes.ReplicationTaskAssessmentRun, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			replicationTaskAssessmentRunStatusCancelling,
+			replicationTaskAssessmentRunStatusDeleting,
+			replicationTaskAssessmentRunStatusFailed,
+			replicationTaskAssessmentRunStatusPassed,
+			replicationTaskAssessmentRunStatusWarning,
+		},
+		Target:     []string{},
+		Refresh:    statusReplicationTaskAssessmentRun(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ReplicationTaskAssessmentRun); ok {
+		return output, err
+	}
+
+	return nil, err
+}
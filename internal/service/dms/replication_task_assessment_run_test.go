@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dms_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databasemigrationservice/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdms "github.com/hashicorp/terraform-provider-aws/internal/service/dms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDMSReplicationTaskAssessmentRun_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ReplicationTaskAssessmentRun
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dms_replication_task_assessment_run.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReplicationTaskAssessmentRunDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationTaskAssessmentRunConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReplicationTaskAssessmentRunExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "assessment_run_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "replication_task_arn", "aws_dms_replication_task.test", "replication_task_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "result_location_bucket", "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "result_location_folder", "assessments"),
+					resource.TestCheckResourceAttrPair(resourceName, "service_access_role_arn", "aws_iam_role.assessment", names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrStatus),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"exclude",
+					"include_only",
+				},
+			},
+		},
+	})
+}
+
+func TestAccDMSReplicationTaskAssessmentRun_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ReplicationTaskAssessmentRun
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dms_replication_task_assessment_run.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReplicationTaskAssessmentRunDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationTaskAssessmentRunConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationTaskAssessmentRunExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfdms.ResourceReplicationTaskAssessmentRun(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckReplicationTaskAssessmentRunExists(ctx context.Context, n string, v *awstypes.ReplicationTaskAssessmentRun) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DMSClient(ctx)
+
+		output, err := tfdms.FindReplicationTaskAssessmentRunByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckReplicationTaskAssessmentRunDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_dms_replication_task_assessment_run" {
+				continue
+			}
+
+			conn := acctest.Provider.Meta().(*conns.AWSClient).DMSClient(ctx)
+
+			_, err := tfdms.FindReplicationTaskAssessmentRunByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DMS Replication Task Assessment Run %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccReplicationTaskAssessmentRunConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccReplicationTaskConfig_basic(rName, "full-load"), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "assessment" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Principal = { Service = "dms.${data.aws_partition.current.dns_suffix}" }
+      Action    = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "assessment" {
+  name = %[1]q
+  role = aws_iam_role.assessment.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = ["s3:PutObject", "s3:DeleteObject", "s3:GetObject", "s3:ListBucket", "s3:GetBucketLocation"]
+      Resource = [aws_s3_bucket.test.arn, "${aws_s3_bucket.test.arn}/*"]
+    }]
+  })
+}
+
+resource "aws_dms_replication_task_assessment_run" "test" {
+  assessment_run_name     = %[1]q
+  replication_task_arn    = aws_dms_replication_task.test.replication_task_arn
+  result_location_bucket  = aws_s3_bucket.test.bucket
+  result_location_folder  = "assessments"
+  service_access_role_arn = aws_iam_role.assessment.arn
+
+  depends_on = [aws_iam_role_policy.assessment]
+}
+`, rName))
+}
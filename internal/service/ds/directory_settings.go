@@ -0,0 +1,271 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ds
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/directoryservice"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/directoryservice/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_directory_service_directory_settings", name="Directory Settings")
+func resourceDirectorySettings() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDirectorySettingsCreate,
+		ReadWithoutTimeout:   resourceDirectorySettingsRead,
+		UpdateWithoutTimeout: resourceDirectorySettingsUpdate,
+		DeleteWithoutTimeout: resourceDirectorySettingsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"setting": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						names.AttrValue: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDirectorySettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DSClient(ctx)
+
+	directoryID := d.Get("directory_id").(string)
+	settings := expandSettings(d.Get("setting").(*schema.Set).List())
+
+	if err := updateSettings(ctx, conn, directoryID, settings, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Directory Service Directory (%s) settings: %s", directoryID, err)
+	}
+
+	d.SetId(directoryID)
+
+	return append(diags, resourceDirectorySettingsRead(ctx, d, meta)...)
+}
+
+func resourceDirectorySettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DSClient(ctx)
+
+	entries, err := findSettingsByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Directory Service Directory (%s) settings not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Directory Service Directory (%s) settings: %s", d.Id(), err)
+	}
+
+	// Only the settings managed by this resource are tracked. On import all settings are read.
+	configured := make(map[string]struct{})
+	for _, v := range expandSettings(d.Get("setting").(*schema.Set).List()) {
+		configured[aws.ToString(v.Name)] = struct{}{}
+	}
+
+	var tfList []interface{}
+	for _, v := range entries {
+		name := aws.ToString(v.Name)
+		if _, ok := configured[name]; len(configured) > 0 && !ok {
+			continue
+		}
+
+		value := v.AppliedValue
+		if v.RequestedValue != nil {
+			value = v.RequestedValue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrName:  name,
+			names.AttrValue: aws.ToString(value),
+		})
+	}
+
+	d.Set("directory_id", d.Id())
+	if err := d.Set("setting", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting setting: %s", err)
+	}
+
+	return diags
+}
+
+func resourceDirectorySettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DSClient(ctx)
+
+	if d.HasChange("setting") {
+		o, n := d.GetChange("setting")
+		settings := expandSettings(n.(*schema.Set).Difference(o.(*schema.Set)).List())
+
+		if len(settings) > 0 {
+			if err := updateSettings(ctx, conn, d.Id(), settings, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Directory Service Directory (%s) settings: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourceDirectorySettingsRead(ctx, d, meta)...)
+}
+
+func resourceDirectorySettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	log.Printf("[WARN] Directory Service Directory (%s) settings cannot be reset to their defaults; removing from state only", d.Id())
+
+	return diags
+}
+
+func updateSettings(ctx context.Context, conn *directoryservice.Client, directoryID string, settings []awstypes.Setting, timeout time.Duration) error {
+	input := &directoryservice.UpdateSettingsInput{
+		DirectoryId: aws.String(directoryID),
+		Settings:    settings,
+	}
+
+	_, err := conn.UpdateSettings(ctx, input)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := waitSettingsUpdated(ctx, conn, directoryID, timeout); err != nil {
+		return fmt.Errorf("waiting for update: %w", err)
+	}
+
+	return nil
+}
+
+func findSettingsByID(ctx context.Context, conn *directoryservice.Client, directoryID string) ([]awstypes.SettingEntry, error) {
+	input := &directoryservice.DescribeSettingsInput{
+		DirectoryId: aws.String(directoryID),
+	}
+	var output []awstypes.SettingEntry
+
+	for {
+		page, err := conn.DescribeSettings(ctx, input)
+
+		if errs.IsA[*awstypes.EntityDoesNotExistException](err) || errs.IsA[*awstypes.DirectoryDoesNotExistException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.SettingEntries...)
+
+		if aws.ToString(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
+
+func statusSettings(ctx context.Context, conn *directoryservice.Client, directoryID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findSettingsByID(ctx, conn, directoryID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		// Settings that haven't been changed report a status of Default.
+		status := awstypes.DirectoryConfigurationStatusUpdated
+		for _, v := range output {
+			switch v.RequestStatus {
+			case awstypes.DirectoryConfigurationStatusRequested, awstypes.DirectoryConfigurationStatusUpdating:
+				status = awstypes.DirectoryConfigurationStatusUpdating
+			case awstypes.DirectoryConfigurationStatusFailed:
+				return output, string(awstypes.DirectoryConfigurationStatusFailed), fmt.Errorf("setting %s: %s", aws.ToString(v.Name), aws.ToString(v.RequestStatusMessage))
+			}
+		}
+
+		return output, string(status), nil
+	}
+}
+
+func waitSettingsUpdated(ctx context.Context, conn *directoryservice.Client, directoryID string, timeout time.Duration) ([]awstypes.SettingEntry, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DirectoryConfigurationStatusRequested, awstypes.DirectoryConfigurationStatusUpdating),
+		Target:  enum.Slice(awstypes.DirectoryConfigurationStatusUpdated),
+		Refresh: statusSettings(ctx, conn, directoryID),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.([]awstypes.SettingEntry); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandSettings(tfList []interface{}) []awstypes.Setting {
+	var apiObjects []awstypes.Setting
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, awstypes.Setting{
+			Name:  aws.String(tfMap[names.AttrName].(string)),
+			Value: aws.String(tfMap[names.AttrValue].(string)),
+		})
+	}
+
+	return apiObjects
+}
@@ -52,6 +52,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  resourceDirectorySettings,
+			TypeName: "aws_directory_service_directory_settings",
+			Name:     "Directory Settings",
+		},
 		{
 			Factory:  resourceLogSubscription,
 			TypeName: "aws_directory_service_log_subscription",
@@ -277,6 +277,13 @@ func resourceAMI() *schema.Resource {
 				ForceNew:         true,
 				ValidateDiagFunc: enum.Validate[awstypes.TpmSupportValues](),
 			},
+			"uefi_data": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true, // this attribute can only be set at registration time
+				ValidateFunc: validation.StringLenBetween(1, 64000),
+			},
 			"usage_operation": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -330,6 +337,10 @@ func resourceAMICreate(ctx context.Context, d *schema.ResourceData, meta interfa
 		input.TpmSupport = awstypes.TpmSupportValues(v)
 	}
 
+	if v := d.Get("uefi_data").(string); v != "" {
+		input.UefiData = aws.String(v)
+	}
+
 	if v, ok := d.GetOk("ebs_block_device"); ok && v.(*schema.Set).Len() > 0 {
 		for _, tfMapRaw := range v.(*schema.Set).List() {
 			tfMap, ok := tfMapRaw.(map[string]interface{})
@@ -451,6 +462,22 @@ func resourceAMIRead(ctx context.Context, d *schema.ResourceData, meta interface
 	d.Set("usage_operation", image.UsageOperation)
 	d.Set("virtualization_type", image.VirtualizationType)
 
+	// UEFI data is only returned by DescribeImageAttribute and only applies to UEFI boot modes.
+	if bootMode := image.BootMode; bootMode == awstypes.BootModeValuesUefi || bootMode == awstypes.BootModeValuesUefiPreferred {
+		uefiData, err := findImageUEFIDataByID(ctx, conn, d.Id())
+
+		switch {
+		case tfresource.NotFound(err):
+			d.Set("uefi_data", nil)
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading EC2 AMI (%s) UEFI data: %s", d.Id(), err)
+		default:
+			d.Set("uefi_data", uefiData)
+		}
+	} else {
+		d.Set("uefi_data", nil)
+	}
+
 	if err := d.Set("ebs_block_device", flattenBlockDeviceMappingsForAMIEBSBlockDevice(image.BlockDeviceMappings)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting ebs_block_device: %s", err)
 	}
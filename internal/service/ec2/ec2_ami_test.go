@@ -447,6 +447,7 @@ func TestAccEC2AMI_boot(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAMIExists(ctx, resourceName, &ami),
 					resource.TestCheckResourceAttr(resourceName, "boot_mode", "uefi"),
+					resource.TestCheckResourceAttr(resourceName, "uefi_data", ""),
 				),
 			},
 			{
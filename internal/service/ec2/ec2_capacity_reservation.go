@@ -105,6 +105,7 @@ func resourceCapacityReservation() *schema.Resource {
 			"outpost_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
+				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
 			names.AttrOwnerID: {
@@ -114,6 +115,7 @@ func resourceCapacityReservation() *schema.Resource {
 			"placement_group_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
+				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
 			names.AttrTags:    tftags.TagsSchema(),
@@ -0,0 +1,367 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ec2_capacity_reservation_fleet", name="Capacity Reservation Fleet")
+// @Tags(identifierAttribute="id")
+// @Testing(tagsTest=false)
+func resourceCapacityReservationFleet() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCapacityReservationFleetCreate,
+		ReadWithoutTimeout:   resourceCapacityReservationFleetRead,
+		UpdateWithoutTimeout: resourceCapacityReservationFleetUpdate,
+		DeleteWithoutTimeout: resourceCapacityReservationFleetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allocation_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "prioritized",
+				ValidateFunc: validation.StringInSlice([]string{"prioritized"}, false),
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"instance_match_criteria": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          awstypes.FleetInstanceMatchCriteriaOpen,
+				ValidateDiagFunc: enum.Validate[awstypes.FleetInstanceMatchCriteria](),
+			},
+			"instance_type_specification": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAvailabilityZone: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"ebs_optimized": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"instance_platform": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.CapacityReservationInstancePlatform](),
+						},
+						names.AttrInstanceType: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						names.AttrPriority: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						names.AttrWeight: {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.FloatBetween(0.001, 99999.999),
+						},
+					},
+				},
+			},
+			names.AttrState: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"tenancy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          awstypes.FleetCapacityReservationTenancyDefault,
+				ValidateDiagFunc: enum.Validate[awstypes.FleetCapacityReservationTenancy](),
+			},
+			"total_fulfilled_capacity": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"total_target_capacity": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+func resourceCapacityReservationFleetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	input := &ec2.CreateCapacityReservationFleetInput{
+		AllocationStrategy:         aws.String(d.Get("allocation_strategy").(string)),
+		ClientToken:                aws.String(id.UniqueId()),
+		InstanceMatchCriteria:      awstypes.FleetInstanceMatchCriteria(d.Get("instance_match_criteria").(string)),
+		InstanceTypeSpecifications: expandReservationFleetInstanceSpecifications(d.Get("instance_type_specification").(*schema.Set).List()),
+		TagSpecifications:          getTagSpecificationsIn(ctx, awstypes.ResourceTypeCapacityReservationFleet),
+		Tenancy:                    awstypes.FleetCapacityReservationTenancy(d.Get("tenancy").(string)),
+		TotalTargetCapacity:        aws.Int32(int32(d.Get("total_target_capacity").(int))),
+	}
+
+	if v, ok := d.GetOk("end_date"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+
+		input.EndDate = aws.Time(v)
+	}
+
+	output, err := conn.CreateCapacityReservationFleet(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EC2 Capacity Reservation Fleet: %s", err)
+	}
+
+	d.SetId(aws.ToString(output.CapacityReservationFleetId))
+
+	if _, err := waitCapacityReservationFleetActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Capacity Reservation Fleet (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceCapacityReservationFleetRead(ctx, d, meta)...)
+}
+
+func resourceCapacityReservationFleetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	fleet, err := findCapacityReservationFleetByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Capacity Reservation Fleet %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Capacity Reservation Fleet (%s): %s", d.Id(), err)
+	}
+
+	d.Set("allocation_strategy", fleet.AllocationStrategy)
+	d.Set(names.AttrARN, fleet.CapacityReservationFleetArn)
+	if fleet.EndDate != nil {
+		d.Set("end_date", aws.ToTime(fleet.EndDate).Format(time.RFC3339))
+	} else {
+		d.Set("end_date", nil)
+	}
+	d.Set("instance_match_criteria", fleet.InstanceMatchCriteria)
+	if err := d.Set("instance_type_specification", flattenFleetCapacityReservations(fleet.InstanceTypeSpecifications)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting instance_type_specification: %s", err)
+	}
+	d.Set(names.AttrState, fleet.State)
+	d.Set("tenancy", fleet.Tenancy)
+	d.Set("total_fulfilled_capacity", fleet.TotalFulfilledCapacity)
+	d.Set("total_target_capacity", fleet.TotalTargetCapacity)
+
+	setTagsOut(ctx, fleet.Tags)
+
+	return diags
+}
+
+func resourceCapacityReservationFleetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &ec2.ModifyCapacityReservationFleetInput{
+			CapacityReservationFleetId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("end_date") {
+			if v, ok := d.GetOk("end_date"); ok {
+				v, _ := time.Parse(time.RFC3339, v.(string))
+
+				input.EndDate = aws.Time(v)
+			} else {
+				input.RemoveEndDate = aws.Bool(true)
+			}
+		}
+
+		if d.HasChange("total_target_capacity") {
+			input.TotalTargetCapacity = aws.Int32(int32(d.Get("total_target_capacity").(int)))
+		}
+
+		_, err := conn.ModifyCapacityReservationFleet(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 Capacity Reservation Fleet (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitCapacityReservationFleetActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for EC2 Capacity Reservation Fleet (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceCapacityReservationFleetRead(ctx, d, meta)...)
+}
+
+func resourceCapacityReservationFleetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	log.Printf("[DEBUG] Deleting EC2 Capacity Reservation Fleet: %s", d.Id())
+	output, err := conn.CancelCapacityReservationFleets(ctx, &ec2.CancelCapacityReservationFleetsInput{
+		CapacityReservationFleetIds: []string{d.Id()},
+	})
+
+	if err == nil && output != nil {
+		err = cancelCapacityReservationFleetsError(output.FailedFleetCancellations)
+	}
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidCapacityReservationFleetIDNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EC2 Capacity Reservation Fleet (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitCapacityReservationFleetDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Capacity Reservation Fleet (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandReservationFleetInstanceSpecification(tfMap map[string]interface{}) awstypes.ReservationFleetInstanceSpecification {
+	apiObject := awstypes.ReservationFleetInstanceSpecification{}
+
+	if v, ok := tfMap[names.AttrAvailabilityZone].(string); ok && v != "" {
+		apiObject.AvailabilityZone = aws.String(v)
+	}
+
+	if v, ok := tfMap["ebs_optimized"].(bool); ok && v {
+		apiObject.EbsOptimized = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["instance_platform"].(string); ok && v != "" {
+		apiObject.InstancePlatform = awstypes.CapacityReservationInstancePlatform(v)
+	}
+
+	if v, ok := tfMap[names.AttrInstanceType].(string); ok && v != "" {
+		apiObject.InstanceType = awstypes.InstanceType(v)
+	}
+
+	if v, ok := tfMap[names.AttrPriority].(int); ok && v != 0 {
+		apiObject.Priority = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap[names.AttrWeight].(float64); ok && v != 0 {
+		apiObject.Weight = aws.Float64(v)
+	}
+
+	return apiObject
+}
+
+func expandReservationFleetInstanceSpecifications(tfList []interface{}) []awstypes.ReservationFleetInstanceSpecification {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []awstypes.ReservationFleetInstanceSpecification
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandReservationFleetInstanceSpecification(tfMap))
+	}
+
+	return apiObjects
+}
+
+func flattenFleetCapacityReservation(apiObject awstypes.FleetCapacityReservation) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"instance_platform":    apiObject.InstancePlatform,
+		names.AttrInstanceType: apiObject.InstanceType,
+	}
+
+	if v := apiObject.AvailabilityZone; v != nil {
+		tfMap[names.AttrAvailabilityZone] = aws.ToString(v)
+	}
+
+	if v := apiObject.EbsOptimized; v != nil {
+		tfMap["ebs_optimized"] = aws.ToBool(v)
+	}
+
+	if v := apiObject.Priority; v != nil {
+		tfMap[names.AttrPriority] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.Weight; v != nil {
+		tfMap[names.AttrWeight] = aws.ToFloat64(v)
+	}
+
+	return tfMap
+}
+
+func flattenFleetCapacityReservations(apiObjects []awstypes.FleetCapacityReservation) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, flattenFleetCapacityReservation(apiObject))
+	}
+
+	return tfList
+}
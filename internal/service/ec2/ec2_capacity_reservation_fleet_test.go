@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2CapacityReservationFleet_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.CapacityReservationFleet
+	resourceName := "aws_ec2_capacity_reservation_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckCapacityReservation(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapacityReservationFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityReservationFleetConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationFleetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "allocation_strategy", "prioritized"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "ec2", regexache.MustCompile(`capacity-reservation-fleet/crf-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "end_date", ""),
+					resource.TestCheckResourceAttr(resourceName, "instance_match_criteria", "open"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type_specification.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "instance_type_specification.*", map[string]string{
+						"instance_platform":    "Linux/UNIX",
+						names.AttrInstanceType: "t3.micro",
+						names.AttrPriority:     acctest.Ct1,
+						names.AttrWeight:       acctest.Ct1,
+					}),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "tenancy", "default"),
+					resource.TestCheckResourceAttr(resourceName, "total_target_capacity", acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCapacityReservationFleetConfig_basic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationFleetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "total_target_capacity", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2CapacityReservationFleet_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.CapacityReservationFleet
+	resourceName := "aws_ec2_capacity_reservation_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckCapacityReservation(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapacityReservationFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityReservationFleetConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationFleetExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceCapacityReservationFleet(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCapacityReservationFleetExists(ctx context.Context, n string, v *awstypes.CapacityReservationFleet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		output, err := tfec2.FindCapacityReservationFleetByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckCapacityReservationFleetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_capacity_reservation_fleet" {
+				continue
+			}
+
+			_, err := tfec2.FindCapacityReservationFleetByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EC2 Capacity Reservation Fleet %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCapacityReservationFleetConfig_basic(rName string, totalTargetCapacity int) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_ec2_capacity_reservation_fleet" "test" {
+  total_target_capacity = %[2]d
+
+  instance_type_specification {
+    availability_zone = data.aws_availability_zones.available.names[0]
+    instance_platform = "Linux/UNIX"
+    instance_type     = "t3.micro"
+    priority          = 1
+    weight            = 1
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, totalTargetCapacity))
+}
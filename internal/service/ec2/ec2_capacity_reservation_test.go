@@ -325,6 +325,35 @@ func TestAccEC2CapacityReservation_instanceType(t *testing.T) {
 	})
 }
 
+func TestAccEC2CapacityReservation_placementGroupARN(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cr awstypes.CapacityReservation
+	resourceName := "aws_ec2_capacity_reservation.test"
+	placementGroupResourceName := "aws_placement_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckCapacityReservation(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapacityReservationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityReservationConfig_placementGroupARN(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationExists(ctx, resourceName, &cr),
+					resource.TestCheckResourceAttrPair(resourceName, "placement_group_arn", placementGroupResourceName, names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccEC2CapacityReservation_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var cr awstypes.CapacityReservation
@@ -587,6 +616,27 @@ resource "aws_ec2_capacity_reservation" "test" {
 `, rName, instanceType))
 }
 
+func testAccCapacityReservationConfig_placementGroupARN(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_placement_group" "test" {
+  name     = %[1]q
+  strategy = "cluster"
+}
+
+resource "aws_ec2_capacity_reservation" "test" {
+  availability_zone   = data.aws_availability_zones.available.names[0]
+  instance_count      = 1
+  instance_platform   = "Linux/UNIX"
+  instance_type       = "c5.large"
+  placement_group_arn = aws_placement_group.test.arn
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
 func testAccCapacityReservationConfig_tags1(tag1Key, tag1Value string) string {
 	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
 resource "aws_ec2_capacity_reservation" "test" {
@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_eip_transfer", name="EIP Transfer")
+func newEIPTransferResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &eipTransferResource{}
+
+	return r, nil
+}
+
+type eipTransferResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpUpdate[eipTransferResourceModel]
+	framework.WithImportByID
+}
+
+func (*eipTransferResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_eip_transfer"
+}
+
+func (r *eipTransferResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"address_transfer_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AddressTransferStatus](),
+				Computed:   true,
+			},
+			"allocation_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"public_ip": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"transfer_account_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			"transfer_offer_accepted_timestamp": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"transfer_offer_expiration_timestamp": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *eipTransferResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data eipTransferResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	input := &ec2.EnableAddressTransferInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.EnableAddressTransfer(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating EC2 EIP Transfer (%s)", data.AllocationID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.AddressTransfer, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = data.AllocationID
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *eipTransferResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data eipTransferResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	output, err := findEIPTransferByAllocationID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EC2 EIP Transfer (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *eipTransferResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data eipTransferResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	output, err := findEIPTransferByAllocationID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EC2 EIP Transfer (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// An accepted transfer can't be undone.
+	if output.AddressTransferStatus != awstypes.AddressTransferStatusPending {
+		return
+	}
+
+	_, err = conn.DisableAddressTransfer(ctx, &ec2.DisableAddressTransferInput{
+		AllocationId: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidAllocationIDNotFound) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting EC2 EIP Transfer (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type eipTransferResourceModel struct {
+	AddressTransferStatus            fwtypes.StringEnum[awstypes.AddressTransferStatus] `tfsdk:"address_transfer_status"`
+	AllocationID                     types.String                                       `tfsdk:"allocation_id"`
+	ID                               types.String                                       `tfsdk:"id"`
+	PublicIP                         types.String                                       `tfsdk:"public_ip"`
+	TransferAccountID                types.String                                       `tfsdk:"transfer_account_id"`
+	TransferOfferAcceptedTimestamp   timetypes.RFC3339                                  `tfsdk:"transfer_offer_accepted_timestamp"`
+	TransferOfferExpirationTimestamp timetypes.RFC3339                                  `tfsdk:"transfer_offer_expiration_timestamp"`
+}
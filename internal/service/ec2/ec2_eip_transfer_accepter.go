@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_eip_transfer_accepter", name="EIP Transfer Accepter")
+func newEIPTransferAccepterResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &eipTransferAccepterResource{}
+
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+
+	return r, nil
+}
+
+type eipTransferAccepterResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpUpdate[eipTransferAccepterResourceModel]
+	// Once accepted, the Elastic IP address belongs to this account and can be managed with aws_eip.
+	framework.WithNoOpDelete
+	framework.WithTimeouts
+}
+
+func (*eipTransferAccepterResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_eip_transfer_accepter"
+}
+
+func (r *eipTransferAccepterResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrAddress: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"allocation_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *eipTransferAccepterResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data eipTransferAccepterResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	input := &ec2.AcceptAddressTransferInput{
+		Address: fwflex.StringFromFramework(ctx, data.Address),
+	}
+
+	output, err := conn.AcceptAddressTransfer(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("accepting EC2 EIP Transfer (%s)", data.Address.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.AllocationID = fwflex.StringToFramework(ctx, output.AddressTransfer.AllocationId)
+	data.ID = data.AllocationID
+
+	if _, err := tfresource.RetryWhenNotFound(ctx, r.CreateTimeout(ctx, data.Timeouts), func() (interface{}, error) {
+		return findEIPByAllocationID(ctx, conn, data.ID.ValueString())
+	}); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for EC2 EIP Transfer (%s) accept", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *eipTransferAccepterResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data eipTransferAccepterResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	output, err := findEIPByAllocationID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EC2 EIP Transfer Accepter (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.Address = fwflex.StringToFramework(ctx, output.PublicIp)
+	data.AllocationID = fwflex.StringToFramework(ctx, output.AllocationId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type eipTransferAccepterResourceModel struct {
+	Address      types.String   `tfsdk:"address"`
+	AllocationID types.String   `tfsdk:"allocation_id"`
+	ID           types.String   `tfsdk:"id"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2EIPTransfer_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_eip_transfer.test"
+	eipResourceName := "aws_eip.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckEIPTransferDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEIPTransferConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEIPTransferExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "address_transfer_status", "pending"),
+					resource.TestCheckResourceAttrPair(resourceName, "allocation_id", eipResourceName, "allocation_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "public_ip", eipResourceName, "public_ip"),
+					resource.TestCheckResourceAttrPair(resourceName, "transfer_account_id", "data.aws_caller_identity.peer", names.AttrAccountID),
+					resource.TestCheckResourceAttrSet(resourceName, "transfer_offer_expiration_timestamp"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2EIPTransfer_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_eip_transfer.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckEIPTransferDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEIPTransferConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEIPTransferExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfec2.ResourceEIPTransfer, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2EIPTransferAccepter_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_eip_transfer_accepter.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		// The accepted address is retained in the alternate account and cleaned up by the EIP sweeper.
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEIPTransferAccepterConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrAddress, "aws_eip_transfer.test", "public_ip"),
+					resource.TestMatchResourceAttr(resourceName, "allocation_id", regexache.MustCompile(`^eipalloc-[0-9a-f]+$`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEIPTransferExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		_, err := tfec2.FindEIPTransferByAllocationID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckEIPTransferDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_eip_transfer" {
+				continue
+			}
+
+			_, err := tfec2.FindEIPTransferByAllocationID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EC2 EIP Transfer %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccEIPTransferConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_caller_identity" "peer" {
+  provider = "awsalternate"
+}
+
+resource "aws_eip" "test" {
+  domain = "vpc"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccEIPTransferConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccEIPTransferConfig_base(rName), `
+resource "aws_eip_transfer" "test" {
+  allocation_id       = aws_eip.test.allocation_id
+  transfer_account_id = data.aws_caller_identity.peer.account_id
+}
+`)
+}
+
+func testAccEIPTransferAccepterConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccEIPTransferConfig_basic(rName), `
+resource "aws_eip_transfer_accepter" "test" {
+  provider = "awsalternate"
+
+  address = aws_eip_transfer.test.public_ip
+}
+`)
+}
@@ -126,6 +126,41 @@ func TestAccEC2Host_instanceFamily(t *testing.T) {
 	})
 }
 
+func TestAccEC2Host_hostMaintenance(t *testing.T) {
+	ctx := acctest.Context(t)
+	var host awstypes.Host
+	resourceName := "aws_ec2_host.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckHostDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHostConfig_hostMaintenance(rName, "off"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHostExists(ctx, resourceName, &host),
+					resource.TestCheckResourceAttr(resourceName, "host_maintenance", "off"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccHostConfig_hostMaintenance(rName, "on"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHostExists(ctx, resourceName, &host),
+					resource.TestCheckResourceAttr(resourceName, "host_maintenance", "on"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2Host_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var host awstypes.Host
@@ -283,6 +318,20 @@ resource "aws_ec2_host" "test" {
 `)
 }
 
+func testAccHostConfig_hostMaintenance(rName, hostMaintenance string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_ec2_host" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  host_maintenance  = %[2]q
+  instance_type     = "c5.large"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, hostMaintenance))
+}
+
 func testAccHostConfig_instanceFamily(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
 resource "aws_ec2_host" "test" {
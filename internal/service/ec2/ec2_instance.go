@@ -312,6 +312,11 @@ func resourceInstance() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"ena_support": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
 			"enclave_options": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -638,6 +643,10 @@ func resourceInstance() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"prevent_stop_on_update": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"primary_network_interface_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -777,6 +786,12 @@ func resourceInstance() *schema.Resource {
 					return ok
 				},
 			},
+			"sriov_net_support": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{sriovNetSupportSimple}, false),
+			},
 			"spot_instance_request_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -939,6 +954,24 @@ func resourceInstance() *schema.Resource {
 
 				return true
 			}),
+			func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+				if diff.Id() == "" || !diff.Get("prevent_stop_on_update").(bool) {
+					return nil
+				}
+
+				attrs := []string{names.AttrInstanceType, "ena_support", "sriov_net_support"}
+				if !diff.Get("user_data_replace_on_change").(bool) {
+					attrs = append(attrs, "user_data", "user_data_base64")
+				}
+
+				for _, attr := range attrs {
+					if diff.HasChange(attr) {
+						return fmt.Errorf("changing %s requires the instance to be stopped and started, but prevent_stop_on_update is enabled", attr)
+					}
+				}
+
+				return nil
+			},
 		),
 	}
 }
@@ -1313,6 +1346,8 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 
 	d.Set("ebs_optimized", instance.EbsOptimized)
+	d.Set("ena_support", instance.EnaSupport)
+	d.Set("sriov_net_support", instance.SriovNetSupport)
 	if aws.ToString(instance.SubnetId) != "" {
 		d.Set("source_dest_check", instance.SourceDestCheck)
 	}
@@ -1773,26 +1808,76 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
-	if d.HasChanges(names.AttrInstanceType, "user_data", "user_data_base64") && !d.IsNewResource() {
-		// For each argument change, we start and stop the instance
-		// to account for behaviors occurring outside terraform.
-		// Only one attribute can be modified at a time, else we get
-		// "InvalidParameterCombination: Fields for multiple attribute types specified"
-		if d.HasChange(names.AttrInstanceType) {
-			if !d.HasChange("capacity_reservation_specification.0.capacity_reservation_target.0.capacity_reservation_id") {
-				instanceType := d.Get(names.AttrInstanceType).(string)
-				input := &ec2.ModifyInstanceAttributeInput{
-					InstanceId: aws.String(d.Id()),
-					InstanceType: &awstypes.AttributeValue{
-						Value: aws.String(instanceType),
-					},
+	// Attributes that can only be modified while the instance is stopped are
+	// batched so that the instance is stopped and started at most once.
+	// Only one attribute can be modified per request, else we get
+	// "InvalidParameterCombination: Fields for multiple attribute types specified".
+	var stopStartInputs []*ec2.ModifyInstanceAttributeInput
+
+	// ENA and SR-IOV support can't be set at launch. For a newly created
+	// instance the configured value is compared with the one inherited from
+	// the AMI. They are modified before any instance type change, as the new
+	// instance type may require them.
+	if d.IsNewResource() {
+		config := d.GetRawConfig()
+		enaSupport, sriovNetSupport := config.GetAttr("ena_support"), config.GetAttr("sriov_net_support")
+
+		if (enaSupport.IsKnown() && !enaSupport.IsNull()) || (sriovNetSupport.IsKnown() && !sriovNetSupport.IsNull()) {
+			instance, err := findInstanceByID(ctx, conn, d.Id())
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading EC2 Instance (%s): %s", d.Id(), err)
+			}
+
+			if enaSupport.IsKnown() && !enaSupport.IsNull() {
+				if v := enaSupport.True(); aws.ToBool(instance.EnaSupport) != v {
+					stopStartInputs = append(stopStartInputs, &ec2.ModifyInstanceAttributeInput{
+						EnaSupport: &awstypes.AttributeBooleanValue{
+							Value: aws.Bool(v),
+						},
+						InstanceId: aws.String(d.Id()),
+					})
 				}
+			}
 
-				if err := modifyInstanceAttributeWithStopStart(ctx, conn, input, fmt.Sprintf("InstanceType (%s)", instanceType)); err != nil {
-					return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s) type: %s", d.Id(), err)
+			if sriovNetSupport.IsKnown() && !sriovNetSupport.IsNull() {
+				if v := sriovNetSupport.AsString(); v != "" && aws.ToString(instance.SriovNetSupport) != v {
+					stopStartInputs = append(stopStartInputs, &ec2.ModifyInstanceAttributeInput{
+						InstanceId: aws.String(d.Id()),
+						SriovNetSupport: &awstypes.AttributeValue{
+							Value: aws.String(v),
+						},
+					})
 				}
 			}
 		}
+	} else {
+		if d.HasChange("ena_support") {
+			stopStartInputs = append(stopStartInputs, &ec2.ModifyInstanceAttributeInput{
+				EnaSupport: &awstypes.AttributeBooleanValue{
+					Value: aws.Bool(d.Get("ena_support").(bool)),
+				},
+				InstanceId: aws.String(d.Id()),
+			})
+		}
+
+		if v := d.Get("sriov_net_support").(string); d.HasChange("sriov_net_support") && v != "" {
+			stopStartInputs = append(stopStartInputs, &ec2.ModifyInstanceAttributeInput{
+				InstanceId: aws.String(d.Id()),
+				SriovNetSupport: &awstypes.AttributeValue{
+					Value: aws.String(v),
+				},
+			})
+		}
+
+		if d.HasChange(names.AttrInstanceType) && !d.HasChange("capacity_reservation_specification.0.capacity_reservation_target.0.capacity_reservation_id") {
+			stopStartInputs = append(stopStartInputs, &ec2.ModifyInstanceAttributeInput{
+				InstanceId: aws.String(d.Id()),
+				InstanceType: &awstypes.AttributeValue{
+					Value: aws.String(d.Get(names.AttrInstanceType).(string)),
+				},
+			})
+		}
 
 		// From the API reference:
 		// "If you are using an AWS SDK or command line tool,
@@ -1806,16 +1891,12 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 				v = []byte(d.Get("user_data").(string))
 			}
 
-			input := &ec2.ModifyInstanceAttributeInput{
+			stopStartInputs = append(stopStartInputs, &ec2.ModifyInstanceAttributeInput{
 				InstanceId: aws.String(d.Id()),
 				UserData: &awstypes.BlobAttributeValue{
 					Value: v,
 				},
-			}
-
-			if err := modifyInstanceAttributeWithStopStart(ctx, conn, input, "UserData"); err != nil {
-				return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s) user data: %s", d.Id(), err)
-			}
+			})
 		}
 
 		if d.HasChange("user_data_base64") {
@@ -1826,16 +1907,18 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 				v = []byte(d.Get("user_data_base64").(string))
 			}
 
-			input := &ec2.ModifyInstanceAttributeInput{
+			stopStartInputs = append(stopStartInputs, &ec2.ModifyInstanceAttributeInput{
 				InstanceId: aws.String(d.Id()),
 				UserData: &awstypes.BlobAttributeValue{
 					Value: v,
 				},
-			}
+			})
+		}
+	}
 
-			if err := modifyInstanceAttributeWithStopStart(ctx, conn, input, "UserData (base64)"); err != nil {
-				return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s) user data base64: %s", d.Id(), err)
-			}
+	if len(stopStartInputs) > 0 {
+		if err := modifyInstanceAttributesWithStopStart(ctx, conn, d.Id(), stopStartInputs); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s): %s", d.Id(), err)
 		}
 	}
 
@@ -2212,19 +2295,19 @@ func disableInstanceAPITermination(ctx context.Context, conn *ec2.Client, id str
 	return nil
 }
 
-// modifyInstanceAttributeWithStopStart modifies a specific attribute provided
-// as input by first stopping the EC2 instance before the modification
-// and then starting up the EC2 instance after modification.
+// modifyInstanceAttributesWithStopStart modifies the attributes provided
+// as inputs by first stopping the EC2 instance before the modifications
+// and then starting up the EC2 instance after all modifications.
 // Reference: https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/Stop_Start.html
-func modifyInstanceAttributeWithStopStart(ctx context.Context, conn *ec2.Client, input *ec2.ModifyInstanceAttributeInput, attrName string) error {
-	id := aws.ToString(input.InstanceId)
-
+func modifyInstanceAttributesWithStopStart(ctx context.Context, conn *ec2.Client, id string, inputs []*ec2.ModifyInstanceAttributeInput) error {
 	if err := stopInstance(ctx, conn, id, false, instanceStopTimeout); err != nil {
 		return err
 	}
 
-	if _, err := conn.ModifyInstanceAttribute(ctx, input); err != nil {
-		return fmt.Errorf("modifying EC2 Instance (%s) %s attribute: %w", id, attrName, err)
+	for _, input := range inputs {
+		if _, err := conn.ModifyInstanceAttribute(ctx, input); err != nil {
+			return fmt.Errorf("modifying EC2 Instance (%s) attribute: %w", id, err)
+		}
 	}
 
 	if err := startInstance(ctx, conn, id, true, instanceStartTimeout); err != nil {
@@ -2185,6 +2185,106 @@ func TestAccEC2Instance_changeInstanceType(t *testing.T) {
 	})
 }
 
+func TestAccEC2Instance_preventStopOnUpdate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var before, after awstypes.Instance
+	resourceName := "aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_preventStopOnUpdate(rName, "t2.medium", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, names.AttrInstanceType, "t2.medium"),
+					resource.TestCheckResourceAttr(resourceName, "prevent_stop_on_update", acctest.CtTrue),
+				),
+			},
+			{
+				Config:      testAccInstanceConfig_preventStopOnUpdate(rName, "t2.large", true),
+				ExpectError: regexache.MustCompile(`prevent_stop_on_update is enabled`),
+			},
+			{
+				Config: testAccInstanceConfig_preventStopOnUpdate(rName, "t2.large", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &after),
+					testAccCheckInstanceNotRecreated(&before, &after),
+					resource.TestCheckResourceAttr(resourceName, names.AttrInstanceType, "t2.large"),
+					resource.TestCheckResourceAttr(resourceName, "prevent_stop_on_update", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2Instance_enaAndSriovNetSupport(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Instance
+	resourceName := "aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_enaAndSriovNetSupport(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ena_support", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "sriov_net_support", "simple"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"user_data_replace_on_change"},
+			},
+		},
+	})
+}
+
+func TestAccEC2Instance_enaSupportWithInstanceType(t *testing.T) {
+	ctx := acctest.Context(t)
+	var before, after awstypes.Instance
+	resourceName := "aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_enaSupport(rName, "t2.medium", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "ena_support", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, names.AttrInstanceType, "t2.medium"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_enaSupport(rName, "t3.medium", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &after),
+					testAccCheckInstanceNotRecreated(&before, &after),
+					resource.TestCheckResourceAttr(resourceName, "ena_support", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, names.AttrInstanceType, "t3.medium"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2Instance_changeInstanceTypeReplace(t *testing.T) {
 	ctx := acctest.Context(t)
 	var before, after awstypes.Instance
@@ -6117,6 +6217,64 @@ resource "aws_instance" "test" {
 `, instanceType, rName, archs))
 }
 
+func testAccInstanceConfig_preventStopOnUpdate(rName, instanceType string, preventStop bool) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		testAccInstanceVPCConfig(rName, false, 0),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami       = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  subnet_id = aws_subnet.test.id
+
+  instance_type          = %[2]q
+  prevent_stop_on_update = %[3]t
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, instanceType, preventStop))
+}
+
+func testAccInstanceConfig_enaSupport(rName, instanceType string, enaSupport bool) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		testAccInstanceVPCConfig(rName, false, 0),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami       = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  subnet_id = aws_subnet.test.id
+
+  instance_type = %[2]q
+  ena_support   = %[3]t
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, instanceType, enaSupport))
+}
+
+func testAccInstanceConfig_enaAndSriovNetSupport(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		testAccInstanceVPCConfig(rName, false, 0),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami       = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  subnet_id = aws_subnet.test.id
+
+  instance_type     = "t3.micro"
+  ena_support       = true
+  sriov_net_support = "simple"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
 func testAccInstanceConfig_typeAndUserData(rName, instanceType, userData string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
@@ -30,6 +30,7 @@ const (
 	errCodeInvalidAssociationIDNotFound                            = "InvalidAssociationID.NotFound"
 	errCodeInvalidAssociationNotFound                              = "InvalidAssociation.NotFound"
 	errCodeInvalidAttachmentIDNotFound                             = "InvalidAttachmentID.NotFound"
+	errCodeInvalidCapacityReservationFleetIDNotFound               = "InvalidCapacityReservationFleetId.NotFound"
 	errCodeInvalidCapacityReservationIdNotFound                    = "InvalidCapacityReservationId.NotFound"
 	errCodeInvalidCarrierGatewayIDNotFound                         = "InvalidCarrierGatewayID.NotFound"
 	errCodeInvalidClientVPNActiveAssociationNotFound               = "InvalidClientVpnActiveAssociationNotFound"
@@ -140,6 +141,26 @@ const (
 	errCodeVolumeInUse                                             = "VolumeInUse"
 )
 
+func cancelCapacityReservationFleetError(apiObject *awstypes.CancelCapacityReservationFleetError) error {
+	if apiObject == nil {
+		return nil
+	}
+
+	return errs.APIError(aws.ToString(apiObject.Code), aws.ToString(apiObject.Message))
+}
+
+func cancelCapacityReservationFleetsError(apiObjects []awstypes.FailedCapacityReservationFleetCancellationResult) error {
+	var errs []error
+
+	for _, apiObject := range apiObjects {
+		if err := cancelCapacityReservationFleetError(apiObject.CancelCapacityReservationFleetError); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", aws.ToString(apiObject.CapacityReservationFleetId), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 func cancelSpotFleetRequestError(apiObject *awstypes.CancelSpotFleetRequestsError) error {
 	if apiObject == nil {
 		return nil
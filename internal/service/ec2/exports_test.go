@@ -10,6 +10,7 @@ var (
 	ResourceAMILaunchPermission                           = resourceAMILaunchPermission
 	ResourceAvailabilityZoneGroup                         = resourceAvailabilityZoneGroup
 	ResourceCapacityReservation                           = resourceCapacityReservation
+	ResourceCapacityReservationFleet                      = resourceCapacityReservationFleet
 	ResourceCarrierGateway                                = resourceCarrierGateway
 	ResourceClientVPNAuthorizationRule                    = resourceClientVPNAuthorizationRule
 	ResourceClientVPNEndpoint                             = resourceClientVPNEndpoint
@@ -28,6 +29,8 @@ var (
 	ResourceEIP                                           = resourceEIP
 	ResourceEIPAssociation                                = resourceEIPAssociation
 	ResourceEIPDomainName                                 = newEIPDomainNameResource
+	ResourceEIPTransfer                                   = newEIPTransferResource
+	ResourceEIPTransferAccepter                           = newEIPTransferAccepterResource
 	ResourceFleet                                         = resourceFleet
 	ResourceFlowLog                                       = resourceFlowLog
 	ResourceHost                                          = resourceHost
@@ -127,6 +130,7 @@ var (
 	ExpandIPPerms                                              = expandIPPerms
 	FindAvailabilityZones                                      = findAvailabilityZones
 	FindCapacityReservationByID                                = findCapacityReservationByID
+	FindCapacityReservationFleetByID                           = findCapacityReservationFleetByID
 	FindCarrierGatewayByID                                     = findCarrierGatewayByID
 	FindClientVPNAuthorizationRuleByThreePartKey               = findClientVPNAuthorizationRuleByThreePartKey
 	FindClientVPNEndpointByID                                  = findClientVPNEndpointByID
@@ -140,6 +144,7 @@ var (
 	FindEIPByAllocationID                                      = findEIPByAllocationID
 	FindEIPByAssociationID                                     = findEIPByAssociationID
 	FindEIPDomainNameAttributeByAllocationID                   = findEIPDomainNameAttributeByAllocationID
+	FindEIPTransferByAllocationID                              = findEIPTransferByAllocationID
 	FindEgressOnlyInternetGatewayByID                          = findEgressOnlyInternetGatewayByID
 	FindFastSnapshotRestoreByTwoPartKey                        = findFastSnapshotRestoreByTwoPartKey
 	FindFleetByID                                              = findFleetByID
@@ -76,6 +76,68 @@ func findAvailabilityZoneGroupByName(ctx context.Context, conn *ec2.Client, name
 	return &availabilityZone, nil
 }
 
+func findCapacityReservationFleet(ctx context.Context, conn *ec2.Client, input *ec2.DescribeCapacityReservationFleetsInput) (*awstypes.CapacityReservationFleet, error) {
+	output, err := findCapacityReservationFleets(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findCapacityReservationFleets(ctx context.Context, conn *ec2.Client, input *ec2.DescribeCapacityReservationFleetsInput) ([]awstypes.CapacityReservationFleet, error) {
+	var output []awstypes.CapacityReservationFleet
+
+	pages := ec2.NewDescribeCapacityReservationFleetsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidCapacityReservationFleetIDNotFound) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.CapacityReservationFleets...)
+	}
+
+	return output, nil
+}
+
+func findCapacityReservationFleetByID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.CapacityReservationFleet, error) {
+	input := &ec2.DescribeCapacityReservationFleetsInput{
+		CapacityReservationFleetIds: []string{id},
+	}
+
+	output, err := findCapacityReservationFleet(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := output.State; state == awstypes.CapacityReservationFleetStateCancelled || state == awstypes.CapacityReservationFleetStateExpired {
+		return nil, &retry.NotFoundError{
+			Message:     string(state),
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.ToString(output.CapacityReservationFleetId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
 func findCapacityReservation(ctx context.Context, conn *ec2.Client, input *ec2.DescribeCapacityReservationsInput) (*awstypes.CapacityReservation, error) {
 	output, err := findCapacityReservations(ctx, conn, input)
 
@@ -4275,6 +4337,25 @@ func findImageLaunchPermissionsByID(ctx context.Context, conn *ec2.Client, id st
 	return output.LaunchPermissions, nil
 }
 
+func findImageUEFIDataByID(ctx context.Context, conn *ec2.Client, id string) (*string, error) {
+	input := &ec2.DescribeImageAttributeInput{
+		Attribute: awstypes.ImageAttributeNameUefiData,
+		ImageId:   aws.String(id),
+	}
+
+	output, err := findImageAttribute(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.UefiData == nil || aws.ToString(output.UefiData.Value) == "" {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.UefiData.Value, nil
+}
+
 func findImageLaunchPermission(ctx context.Context, conn *ec2.Client, imageID, accountID, group, organizationARN, organizationalUnitARN string) (*awstypes.LaunchPermission, error) {
 	output, err := findImageLaunchPermissionsByID(ctx, conn, imageID)
 
@@ -5448,6 +5529,68 @@ func findEIPDomainNameAttributeByAllocationID(ctx context.Context, conn *ec2.Cli
 	return output, nil
 }
 
+func findEIPTransfers(ctx context.Context, conn *ec2.Client, input *ec2.DescribeAddressTransfersInput) ([]awstypes.AddressTransfer, error) {
+	var output []awstypes.AddressTransfer
+
+	pages := ec2.NewDescribeAddressTransfersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidAllocationIDNotFound) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.AddressTransfers...)
+	}
+
+	return output, nil
+}
+
+func findEIPTransfer(ctx context.Context, conn *ec2.Client, input *ec2.DescribeAddressTransfersInput) (*awstypes.AddressTransfer, error) {
+	output, err := findEIPTransfers(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findEIPTransferByAllocationID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.AddressTransfer, error) {
+	input := &ec2.DescribeAddressTransfersInput{
+		AllocationIds: []string{id},
+	}
+
+	output, err := findEIPTransfer(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if status := output.AddressTransferStatus; status == awstypes.AddressTransferStatusDisabled {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.ToString(output.AllocationId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
 func findKeyPair(ctx context.Context, conn *ec2.Client, input *ec2.DescribeKeyPairsInput) (*awstypes.KeyPairInfo, error) {
 	output, err := findKeyPairs(ctx, conn, input)
 
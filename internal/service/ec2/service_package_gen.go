@@ -46,6 +46,14 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newEIPDomainNameResource,
 			Name:    "EIP Domain Name",
 		},
+		{
+			Factory: newEIPTransferResource,
+			Name:    "EIP Transfer",
+		},
+		{
+			Factory: newEIPTransferAccepterResource,
+			Name:    "EIP Transfer Accepter",
+		},
 		{
 			Factory: newInstanceConnectEndpointResource,
 			Name:    "Instance Connect Endpoint",
@@ -485,6 +493,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_vpc_endpoint_service",
 			Name:     "Endpoint Service",
 		},
+		{
+			Factory:  dataSourceVPCEndpointServices,
+			TypeName: "aws_vpc_endpoint_services",
+			Name:     "Endpoint Services",
+		},
 		{
 			Factory:  dataSourceIPAMPool,
 			TypeName: "aws_vpc_ipam_pool",
@@ -677,6 +690,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  resourceCapacityReservationFleet,
+			TypeName: "aws_ec2_capacity_reservation_fleet",
+			Name:     "Capacity Reservation Fleet",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrID,
+			},
+		},
 		{
 			Factory:  resourceCarrierGateway,
 			TypeName: "aws_ec2_carrier_gateway",
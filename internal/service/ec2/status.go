@@ -46,6 +46,22 @@ func statusCapacityReservation(ctx context.Context, conn *ec2.Client, id string)
 	}
 }
 
+func statusCapacityReservationFleet(ctx context.Context, conn *ec2.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findCapacityReservationFleetByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.State), nil
+	}
+}
+
 func statusCarrierGateway(ctx context.Context, conn *ec2.Client, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findCarrierGatewayByID(ctx, conn, id)
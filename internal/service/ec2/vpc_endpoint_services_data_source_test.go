@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCEndpointServicesDataSource_serviceType(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_vpc_endpoint_services.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCEndpointServicesDataSourceConfig_serviceType("Gateway"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "service_ids.#", 0),
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "service_names.#", 0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCEndpointServicesDataSource_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_vpc_endpoint_services.test"
+	resourceName := "aws_vpc_endpoint_service.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCEndpointServicesDataSourceConfig_tags(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "service_ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "service_ids.0", resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "service_names.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "service_names.0", resourceName, names.AttrServiceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCEndpointServicesDataSourceConfig_serviceType(serviceType string) string {
+	return fmt.Sprintf(`
+data "aws_vpc_endpoint_services" "test" {
+  service_type = %[1]q
+}
+`, serviceType)
+}
+
+func testAccVPCEndpointServicesDataSourceConfig_tags(rName string) string {
+	return acctest.ConfigCompose(testAccVPCEndpointServiceDataSourceConfig_customBase(rName), `
+data "aws_vpc_endpoint_services" "test" {
+  tags = {
+    Name = aws_vpc_endpoint_service.test.tags["Name"]
+  }
+}
+`)
+}
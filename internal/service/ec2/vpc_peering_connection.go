@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -52,6 +53,16 @@ func resourceVPCPeeringConnection() *schema.Resource {
 				Computed: true,
 			},
 			"accepter": vpcPeeringConnectionOptionsSchema,
+			"accepter_cidr_blocks": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"accepter_ipv6_cidr_blocks": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"auto_accept": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -73,7 +84,17 @@ func resourceVPCPeeringConnection() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
-			"requester":       vpcPeeringConnectionOptionsSchema,
+			"requester": vpcPeeringConnectionOptionsSchema,
+			"requester_cidr_blocks": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"requester_ipv6_cidr_blocks": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			names.AttrVPCID: {
@@ -172,7 +193,11 @@ func resourceVPCPeeringConnectionRead(ctx context.Context, d *schema.ResourceDat
 	}
 
 	d.Set("accept_status", vpcPeeringConnection.Status.Code)
+	d.Set("accepter_cidr_blocks", flattenVPCPeeringConnectionVPCInfoCIDRBlocks(vpcPeeringConnection.AccepterVpcInfo))
+	d.Set("accepter_ipv6_cidr_blocks", flattenVPCPeeringConnectionVPCInfoIPv6CIDRBlocks(vpcPeeringConnection.AccepterVpcInfo))
 	d.Set("peer_region", vpcPeeringConnection.AccepterVpcInfo.Region)
+	d.Set("requester_cidr_blocks", flattenVPCPeeringConnectionVPCInfoCIDRBlocks(vpcPeeringConnection.RequesterVpcInfo))
+	d.Set("requester_ipv6_cidr_blocks", flattenVPCPeeringConnectionVPCInfoIPv6CIDRBlocks(vpcPeeringConnection.RequesterVpcInfo))
 
 	if accountID := meta.(*conns.AWSClient).AccountID; accountID == aws.ToString(vpcPeeringConnection.AccepterVpcInfo.OwnerId) && accountID != aws.ToString(vpcPeeringConnection.RequesterVpcInfo.OwnerId) {
 		// We're the accepter.
@@ -386,3 +411,23 @@ func flattenVPCPeeringConnectionOptionsDescription(apiObject *awstypes.VpcPeerin
 
 	return tfMap
 }
+
+func flattenVPCPeeringConnectionVPCInfoCIDRBlocks(apiObject *awstypes.VpcPeeringConnectionVpcInfo) []string {
+	if apiObject == nil {
+		return nil
+	}
+
+	return tfslices.ApplyToAll(apiObject.CidrBlockSet, func(v awstypes.CidrBlock) string {
+		return aws.ToString(v.CidrBlock)
+	})
+}
+
+func flattenVPCPeeringConnectionVPCInfoIPv6CIDRBlocks(apiObject *awstypes.VpcPeeringConnectionVpcInfo) []string {
+	if apiObject == nil {
+		return nil
+	}
+
+	return tfslices.ApplyToAll(apiObject.Ipv6CidrBlockSet, func(v awstypes.Ipv6CidrBlock) string {
+		return aws.ToString(v.Ipv6CidrBlock)
+	})
+}
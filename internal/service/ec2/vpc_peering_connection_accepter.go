@@ -53,6 +53,16 @@ func resourceVPCPeeringConnectionAccepter() *schema.Resource {
 				Computed: true,
 			},
 			"accepter": vpcPeeringConnectionOptionsSchema,
+			"accepter_cidr_blocks": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"accepter_ipv6_cidr_blocks": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"auto_accept": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -69,7 +79,17 @@ func resourceVPCPeeringConnectionAccepter() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"requester":       vpcPeeringConnectionOptionsSchema,
+			"requester": vpcPeeringConnectionOptionsSchema,
+			"requester_cidr_blocks": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"requester_ipv6_cidr_blocks": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			names.AttrVPCID: {
@@ -40,6 +40,12 @@ func TestAccVPCPeeringConnection_basic(t *testing.T) {
 				Config: testAccVPCPeeringConnectionConfig_basic(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckVPCPeeringConnectionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "accepter_cidr_blocks.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "accepter_cidr_blocks.*", "10.1.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "accepter_ipv6_cidr_blocks.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "requester_cidr_blocks.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "requester_cidr_blocks.*", "10.0.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "requester_ipv6_cidr_blocks.#", acctest.Ct0),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
 				),
 			},
@@ -343,6 +343,10 @@ func resourceVPNConnection() *schema.Resource {
 					return false
 				},
 			},
+			"tunnel1_replacement_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"tunnel1_replay_window_size": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -559,6 +563,10 @@ func resourceVPNConnection() *schema.Resource {
 					return false
 				},
 			},
+			"tunnel2_replacement_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"tunnel2_replay_window_size": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -931,6 +939,25 @@ func resourceVPNConnectionUpdate(ctx context.Context, d *schema.ResourceData, me
 				return sdkdiag.AppendErrorf(diags, "waiting for EC2 VPN Connection (%s) tunnel (%d) options update: %s", d.Id(), i+1, err)
 			}
 		}
+
+		// Any change to the trigger value replaces the tunnel endpoint, applying pending maintenance.
+		if address := d.Get(prefix + names.AttrAddress).(string); d.HasChange(prefix+"replacement_trigger") && address != "" {
+			input := &ec2.ReplaceVpnTunnelInput{
+				ApplyPendingMaintenance:   aws.Bool(true),
+				VpnConnectionId:           aws.String(d.Id()),
+				VpnTunnelOutsideIpAddress: aws.String(address),
+			}
+
+			_, err := conn.ReplaceVpnTunnel(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "replacing EC2 VPN Connection (%s) tunnel (%d): %s", d.Id(), i+1, err)
+			}
+
+			if _, err := waitVPNConnectionUpdated(ctx, conn, d.Id()); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for EC2 VPN Connection (%s) tunnel (%d) replace: %s", d.Id(), i+1, err)
+			}
+		}
 	}
 
 	return append(diags, resourceVPNConnectionRead(ctx, d, meta)...)
@@ -1340,6 +1340,38 @@ func TestAccSiteVPNConnection_enableAcceleration(t *testing.T) {
 	})
 }
 
+func TestAccSiteVPNConnection_tunnelReplacement(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rBgpAsn := sdkacctest.RandIntRange(64512, 65534)
+	resourceName := "aws_vpn_connection.test"
+	var vpn1, vpn2 awstypes.VpnConnection
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPNConnectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSiteVPNConnectionConfig_tunnelReplacement(rName, rBgpAsn, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccVPNConnectionExists(ctx, resourceName, &vpn1),
+					resource.TestCheckResourceAttr(resourceName, "tunnel1_replacement_trigger", "1"),
+				),
+			},
+			{
+				Config: testAccSiteVPNConnectionConfig_tunnelReplacement(rName, rBgpAsn, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccVPNConnectionExists(ctx, resourceName, &vpn2),
+					testAccCheckVPNConnectionNotRecreated(&vpn1, &vpn2),
+					resource.TestCheckResourceAttr(resourceName, "tunnel1_replacement_trigger", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSiteVPNConnection_ipv6(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -2176,6 +2208,38 @@ resource "aws_vpn_connection" "test" {
 `, rName, rBgpAsn)
 }
 
+func testAccSiteVPNConnectionConfig_tunnelReplacement(rName string, rBgpAsn int, trigger string) string {
+	return fmt.Sprintf(`
+resource "aws_vpn_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_customer_gateway" "test" {
+  bgp_asn    = %[2]d
+  ip_address = "178.0.0.1"
+  type       = "ipsec.1"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpn_connection" "test" {
+  vpn_gateway_id      = aws_vpn_gateway.test.id
+  customer_gateway_id = aws_customer_gateway.test.id
+  type                = "ipsec.1"
+
+  tunnel1_replacement_trigger = %[3]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, rBgpAsn, trigger)
+}
+
 func testAccSiteVPNConnectionConfig_ipv6(rName string, rBgpAsn int, localIpv6NetworkCidr string, remoteIpv6NetworkCidr string, tunnel1InsideIpv6Cidr string, tunnel2InsideIpv6Cidr string) string {
 	return fmt.Sprintf(`
 resource "aws_ec2_transit_gateway" "test" {
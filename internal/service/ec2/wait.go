@@ -132,6 +132,40 @@ func waitCapacityReservationDeleted(ctx context.Context, conn *ec2.Client, id st
 	return nil, err
 }
 
+func waitCapacityReservationFleetActive(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.CapacityReservationFleet, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.CapacityReservationFleetStateSubmitted, awstypes.CapacityReservationFleetStateModifying),
+		Target:  enum.Slice(awstypes.CapacityReservationFleetStateActive, awstypes.CapacityReservationFleetStatePartiallyFulfilled),
+		Refresh: statusCapacityReservationFleet(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.CapacityReservationFleet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCapacityReservationFleetDeleted(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.CapacityReservationFleet, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.CapacityReservationFleetStateActive, awstypes.CapacityReservationFleetStatePartiallyFulfilled, awstypes.CapacityReservationFleetStateCancelling),
+		Target:  []string{},
+		Refresh: statusCapacityReservationFleet(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.CapacityReservationFleet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitCapacityBlockReservationActive(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.CapacityReservation, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:    enum.Slice(awstypes.CapacityReservationStatePaymentPending),
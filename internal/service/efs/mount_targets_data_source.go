@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package efs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/efs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/efs/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_efs_mount_targets", name="Mount Target")
+func dataSourceMountTargets() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceMountTargetsRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrFileSystemID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			names.AttrIDs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"mount_targets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_zone_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"availability_zone_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrIPAddress: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"life_cycle_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrNetworkInterfaceID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrSubnetID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMountTargetsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EFSClient(ctx)
+
+	fileSystemID := d.Get(names.AttrFileSystemID).(string)
+	input := &efs.DescribeMountTargetsInput{
+		FileSystemId: aws.String(fileSystemID),
+	}
+
+	output, err := findMountTargets(ctx, conn, input, tfslices.PredicateTrue[*awstypes.MountTargetDescription]())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EFS Mount Targets: %s", err)
+	}
+
+	var mountTargetIDs []string
+
+	for _, v := range output {
+		mountTargetIDs = append(mountTargetIDs, aws.ToString(v.MountTargetId))
+	}
+
+	d.SetId(fileSystemID)
+	d.Set(names.AttrIDs, mountTargetIDs)
+	if err := d.Set("mount_targets", flattenMountTargetDescriptions(output)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting mount_targets: %s", err)
+	}
+
+	return diags
+}
+
+func flattenMountTargetDescriptions(apiObjects []awstypes.MountTargetDescription) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"availability_zone_id":       aws.ToString(apiObject.AvailabilityZoneId),
+			"availability_zone_name":     aws.ToString(apiObject.AvailabilityZoneName),
+			names.AttrID:                 aws.ToString(apiObject.MountTargetId),
+			names.AttrIPAddress:          aws.ToString(apiObject.IpAddress),
+			"life_cycle_state":           string(apiObject.LifeCycleState),
+			names.AttrNetworkInterfaceID: aws.ToString(apiObject.NetworkInterfaceId),
+			names.AttrSubnetID:           aws.ToString(apiObject.SubnetId),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package efs_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEFSMountTargetsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_efs_mount_targets.test"
+	resourceName := "aws_efs_mount_target.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EFSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMountTargetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMountTargetsDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "mount_targets.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "mount_targets.0.availability_zone_id", resourceName, "availability_zone_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "mount_targets.0.availability_zone_name", resourceName, "availability_zone_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "mount_targets.0.id", resourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "mount_targets.0.ip_address", resourceName, names.AttrIPAddress),
+					resource.TestCheckResourceAttr(dataSourceName, "mount_targets.0.life_cycle_state", "available"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "mount_targets.0.network_interface_id", resourceName, names.AttrNetworkInterfaceID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "mount_targets.0.subnet_id", resourceName, names.AttrSubnetID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEFSMountTargetsDataSource_empty(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_efs_mount_targets.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EFSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFileSystemDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMountTargetsDataSourceConfig_empty(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(dataSourceName, "mount_targets.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func testAccMountTargetsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_efs_file_system" "test" {
+  creation_token = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_efs_mount_target" "test" {
+  file_system_id = aws_efs_file_system.test.id
+  subnet_id      = aws_subnet.test[0].id
+}
+
+data "aws_efs_mount_targets" "test" {
+  file_system_id = aws_efs_mount_target.test.file_system_id
+}
+`, rName))
+}
+
+func testAccMountTargetsDataSourceConfig_empty() string {
+	return `
+resource "aws_efs_file_system" "test" {}
+
+data "aws_efs_mount_targets" "test" {
+  file_system_id = aws_efs_file_system.test.id
+}
+`
+}
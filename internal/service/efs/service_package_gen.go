@@ -46,6 +46,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_efs_mount_target",
 			Name:     "Mount Target",
 		},
+		{
+			Factory:  dataSourceMountTargets,
+			TypeName: "aws_efs_mount_targets",
+			Name:     "Mount Target",
+		},
 	}
 }
 
@@ -5,6 +5,7 @@ package eks
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -20,6 +21,10 @@ func dataSourceClusterAuth() *schema.Resource {
 		ReadWithoutTimeout: dataSourceClusterAuthRead,
 
 		Schema: map[string]*schema.Schema{
+			"expiration": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			names.AttrName: {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -49,6 +54,7 @@ func dataSourceClusterAuthRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	d.SetId(name)
+	d.Set("expiration", token.Expiration.Format(time.RFC3339))
 	d.Set("token", token.Token)
 
 	return diags
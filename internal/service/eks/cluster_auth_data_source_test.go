@@ -26,6 +26,7 @@ func TestAccEKSClusterAuthDataSource_basic(t *testing.T) {
 			{
 				Config: testAccClusterAuthDataSourceConfig_basic,
 				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceResourceName, "expiration"),
 					resource.TestCheckResourceAttr(dataSourceResourceName, names.AttrName, "foobar"),
 					resource.TestCheckResourceAttrSet(dataSourceResourceName, "token"),
 					testAccCheckClusterAuthToken(dataSourceResourceName),
@@ -190,7 +190,6 @@ func resourceGlobalReplicationGroup() *schema.Resource {
 			"primary_replication_group_id": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validateReplicationGroupID,
 			},
 			"transit_encryption_enabled": {
@@ -208,6 +207,7 @@ func resourceGlobalReplicationGroup() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customizeDiffGlobalReplicationGroupEngineVersionErrorOnDowngrade,
 			customizeDiffGlobalReplicationGroupParamGroupNameRequiresMajorVersionUpgrade,
+			customizeDiffGlobalReplicationGroupPrimaryReplicationGroupID,
 			customdiff.ComputedIf("global_node_groups", diffHasChange("num_node_groups")),
 		),
 	}
@@ -234,6 +234,28 @@ func customizeDiffGlobalReplicationGroupParamGroupNameRequiresMajorVersionUpgrad
 	return paramGroupNameRequiresMajorVersionUpgrade(diff)
 }
 
+// Changing primary_replication_group_id to an existing secondary member promotes that member (failover).
+// Any other change requires replacement.
+func customizeDiffGlobalReplicationGroupPrimaryReplicationGroupID(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
+	if diff.Id() == "" || !diff.HasChange("primary_replication_group_id") {
+		return nil
+	}
+
+	if !diff.NewValueKnown("primary_replication_group_id") {
+		return diff.ForceNew("primary_replication_group_id")
+	}
+
+	conn := meta.(*conns.AWSClient).ElastiCacheClient(ctx)
+
+	_, err := findGlobalReplicationGroupMemberByID(ctx, conn, diff.Id(), diff.Get("primary_replication_group_id").(string))
+
+	if tfresource.NotFound(err) {
+		return diff.ForceNew("primary_replication_group_id")
+	}
+
+	return err
+}
+
 // parameter_group_name can only be set when doing a major update,
 // but we also should allow it to stay set afterwards
 func paramGroupNameRequiresMajorVersionUpgrade(diff sdkv2.ResourceDiffer) error {
@@ -418,6 +440,12 @@ func resourceGlobalReplicationGroupUpdate(ctx context.Context, d *schema.Resourc
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ElastiCacheClient(ctx)
 
+	if d.HasChange("primary_replication_group_id") {
+		if err := failoverGlobalReplicationGroup(ctx, conn, d.Id(), d.Get("primary_replication_group_id").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	// Only one field can be changed per request
 	if d.HasChange("cache_node_type") {
 		if err := updateGlobalReplicationGroup(ctx, conn, d.Id(), globalReplicationGroupNodeTypeUpdater(d.Get("cache_node_type").(string)), "node type", d.Timeout(schema.TimeoutUpdate)); err != nil {
@@ -543,6 +571,34 @@ func updateGlobalReplicationGroup(ctx context.Context, conn *elasticache.Client,
 	return nil
 }
 
+func failoverGlobalReplicationGroup(ctx context.Context, conn *elasticache.Client, id, primaryReplicationGroupID string, timeout time.Duration) error {
+	member, err := findGlobalReplicationGroupMemberByID(ctx, conn, id, primaryReplicationGroupID)
+
+	if err != nil {
+		return fmt.Errorf("reading ElastiCache Global Replication Group (%s) member (%s): %w", id, primaryReplicationGroupID, err)
+	}
+
+	input := &elasticache.FailoverGlobalReplicationGroupInput{
+		GlobalReplicationGroupId:  aws.String(id),
+		PrimaryRegion:             member.ReplicationGroupRegion,
+		PrimaryReplicationGroupId: aws.String(primaryReplicationGroupID),
+	}
+
+	_, err = tfresource.RetryWhenIsA[*awstypes.InvalidGlobalReplicationGroupStateFault](ctx, timeout, func() (interface{}, error) {
+		return conn.FailoverGlobalReplicationGroup(ctx, input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("failing over ElastiCache Global Replication Group (%s) to Replication Group (%s): %w", id, primaryReplicationGroupID, err)
+	}
+
+	if _, err := waitGlobalReplicationGroupAvailable(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("waiting for ElastiCache Global Replication Group (%s) failover: %w", id, err)
+	}
+
+	return nil
+}
+
 func increaseGlobalReplicationGroupNodeGroupCount(ctx context.Context, conn *elasticache.Client, id string, newNodeGroupCount int, timeout time.Duration) error {
 	input := &elasticache.IncreaseNodeGroupsInGlobalReplicationGroupInput{
 		ApplyImmediately:         aws.Bool(true),
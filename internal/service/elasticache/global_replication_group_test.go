@@ -513,6 +513,43 @@ func TestAccElastiCacheGlobalReplicationGroup_ReplaceSecondary_differentRegion(t
 	})
 }
 
+func TestAccElastiCacheGlobalReplicationGroup_failover(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var globalReplcationGroup awstypes.GlobalReplicationGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elasticache_global_replication_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElastiCacheServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesMultipleRegions(ctx, t, 2),
+		CheckDestroy:             testAccCheckGlobalReplicationGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalReplicationGroupConfig_failover(rName, rName+"-p"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGlobalReplicationGroupExists(ctx, resourceName, &globalReplcationGroup),
+					resource.TestCheckResourceAttr(resourceName, "primary_replication_group_id", rName+"-p"),
+				),
+			},
+			{
+				Config: testAccGlobalReplicationGroupConfig_failover(rName, rName+"-s"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGlobalReplicationGroupExists(ctx, resourceName, &globalReplcationGroup),
+					resource.TestCheckResourceAttr(resourceName, "primary_replication_group_id", rName+"-s"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccElastiCacheGlobalReplicationGroup_clusterMode_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -1790,6 +1827,54 @@ resource "aws_elasticache_replication_group" "third" {
 `, rName))
 }
 
+func testAccGlobalReplicationGroupConfig_failover(rName, primaryReplicationGroupID string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigMultipleRegionProvider(2),
+		testAccVPCBaseWithProvider(rName, "primary", acctest.ProviderName, 1),
+		testAccVPCBaseWithProvider(rName, "secondary", acctest.ProviderNameAlternate, 1),
+		fmt.Sprintf(`
+resource "aws_elasticache_global_replication_group" "test" {
+  provider = aws
+
+  global_replication_group_id_suffix = %[1]q
+  primary_replication_group_id       = %[2]q
+
+  depends_on = [aws_elasticache_replication_group.primary]
+}
+
+resource "aws_elasticache_replication_group" "primary" {
+  provider = aws
+
+  replication_group_id = "%[1]s-p"
+  description          = "primary"
+
+  subnet_group_name = aws_elasticache_subnet_group.primary.name
+
+  node_type = "cache.m5.large"
+
+  engine             = "redis"
+  engine_version     = "6.2"
+  num_cache_clusters = 1
+
+  lifecycle {
+    ignore_changes = [global_replication_group_id]
+  }
+}
+
+resource "aws_elasticache_replication_group" "secondary" {
+  provider = awsalternate
+
+  replication_group_id        = "%[1]s-s"
+  description                 = "secondary"
+  global_replication_group_id = aws_elasticache_global_replication_group.test.global_replication_group_id
+
+  subnet_group_name = aws_elasticache_subnet_group.secondary.name
+
+  num_cache_clusters = 1
+}
+`, rName, primaryReplicationGroupID))
+}
+
 func testAccGlobalReplicationGroupConfig_replaceSecondaryDifferentRegionSetup(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigMultipleRegionProvider(3),
@@ -11,6 +11,7 @@ import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 	"log"
 	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -21,6 +22,7 @@ import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -78,6 +80,43 @@ const (
 	environmentTierTypeStandard = "Standard"
 )
 
+const (
+	deploymentPolicyAllAtOnce                  = "AllAtOnce"
+	deploymentPolicyImmutable                  = "Immutable"
+	deploymentPolicyRolling                    = "Rolling"
+	deploymentPolicyRollingWithAdditionalBatch = "RollingWithAdditionalBatch"
+	deploymentPolicyTrafficSplitting           = "TrafficSplitting"
+)
+
+func deploymentPolicy_Values() []string {
+	return []string{
+		deploymentPolicyAllAtOnce,
+		deploymentPolicyImmutable,
+		deploymentPolicyRolling,
+		deploymentPolicyRollingWithAdditionalBatch,
+		deploymentPolicyTrafficSplitting,
+	}
+}
+
+const (
+	batchSizeTypeFixed      = "Fixed"
+	batchSizeTypePercentage = "Percentage"
+)
+
+func batchSizeType_Values() []string {
+	return []string{
+		batchSizeTypeFixed,
+		batchSizeTypePercentage,
+	}
+}
+
+const (
+	optionNamespaceCommand     = "aws:elasticbeanstalk:command"
+	optionNameBatchSize        = "BatchSize"
+	optionNameBatchSizeType    = "BatchSizeType"
+	optionNameDeploymentPolicy = "DeploymentPolicy"
+)
+
 var (
 	environmentCNAMERegex = regexache.MustCompile(`(^[^.]+)(.\w{2}-\w{4,9}-\d)?\.(elasticbeanstalk\.com|eb\.amazonaws\.com\.cn)$`)
 )
@@ -96,7 +135,10 @@ func resourceEnvironment() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			verify.SetTagsDiff,
+			customizeDiffDeploymentPolicy,
+		),
 
 		SchemaVersion: 1,
 		MigrateState:  EnvironmentMigrateState,
@@ -132,6 +174,32 @@ func resourceEnvironment() *schema.Resource {
 					Computed: true,
 					ForceNew: true,
 				},
+				"deployment_policy": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"batch_size": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(1, 10000),
+							},
+							"batch_size_type": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.StringInSlice(batchSizeType_Values(), false),
+							},
+							names.AttrPolicy: {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice(deploymentPolicy_Values(), false),
+							},
+						},
+					},
+				},
 				names.AttrDescription: {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -212,6 +280,11 @@ func resourceEnvironment() *schema.Resource {
 					Optional: true,
 					Computed: true,
 				},
+				"wait_for_health": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice(enum.Slice(awstypes.EnvironmentHealthGreen, awstypes.EnvironmentHealthYellow), false),
+				},
 				"wait_for_ready_timeout": {
 					Type:             schema.TypeString,
 					Optional:         true,
@@ -246,6 +319,10 @@ func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.OptionSettings = expandConfigurationOptionSettings(v.(*schema.Set).List())
 	}
 
+	if v, ok := d.GetOk("deployment_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.OptionSettings = append(input.OptionSettings, expandDeploymentPolicyOptionSettings(v.([]interface{})[0].(map[string]interface{}))...)
+	}
+
 	if v := d.Get("solution_stack_name"); v.(string) != "" {
 		input.SolutionStackName = aws.String(v.(string))
 	}
@@ -305,6 +382,12 @@ func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "waiting for Elastic Beanstalk Environment (%s) create: %s", d.Id(), err)
 	}
 
+	if v, ok := d.GetOk("wait_for_health"); ok {
+		if _, err := waitEnvironmentHealthy(ctx, conn, d.Id(), awstypes.EnvironmentHealth(v.(string)), pollInterval, waitForReadyTimeOut); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Elastic Beanstalk Environment (%s) health: %s", d.Id(), err)
+		}
+	}
+
 	err = findEnvironmentErrorsByID(ctx, conn, d.Id(), opTime)
 
 	if err != nil {
@@ -368,6 +451,12 @@ func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta i
 	} else {
 		d.Set("cname_prefix", "")
 	}
+	// Deployment policy options always have values, so only track them if configured.
+	if _, ok := d.GetOk("deployment_policy"); ok {
+		if err := d.Set("deployment_policy", flattenDeploymentPolicyOptionSettings(configurationSettings.OptionSettings)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting deployment_policy: %s", err)
+		}
+	}
 	d.Set(names.AttrDescription, env.Description)
 	d.Set("endpoint_url", env.EndpointURL)
 	if err := d.Set("instances", flattenInstances(resources.EnvironmentResources.Instances)); err != nil {
@@ -441,7 +530,7 @@ func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	opTime := time.Now()
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "poll_interval", "wait_for_ready_timeout") {
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "poll_interval", "wait_for_health", "wait_for_ready_timeout") {
 		if d.HasChange(names.AttrTagsAll) {
 			if _, err := waitEnvironmentReady(ctx, conn, d.Id(), pollInterval, waitForReadyTimeOut); err != nil {
 				return sdkdiag.AppendErrorf(diags, "waiting for Elastic Beanstalk Environment (%s) tags update: %s", d.Id(), err)
@@ -515,6 +604,19 @@ func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta
 			input.OptionSettings = add
 		}
 
+		if d.HasChange("deployment_policy") {
+			if v, ok := d.GetOk("deployment_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.OptionSettings = append(input.OptionSettings, expandDeploymentPolicyOptionSettings(v.([]interface{})[0].(map[string]interface{}))...)
+			} else {
+				for _, v := range []string{optionNameDeploymentPolicy, optionNameBatchSizeType, optionNameBatchSize} {
+					input.OptionsToRemove = append(input.OptionsToRemove, awstypes.OptionSpecification{
+						Namespace:  aws.String(optionNamespaceCommand),
+						OptionName: aws.String(v),
+					})
+				}
+			}
+		}
+
 		if d.HasChange("solution_stack_name") {
 			if v, ok := d.GetOk("solution_stack_name"); ok {
 				input.SolutionStackName = aws.String(v.(string))
@@ -542,6 +644,12 @@ func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "waiting for Elastic Beanstalk Environment (%s) update: %s", d.Id(), err)
 	}
 
+	if v, ok := d.GetOk("wait_for_health"); ok {
+		if _, err := waitEnvironmentHealthy(ctx, conn, d.Id(), awstypes.EnvironmentHealth(v.(string)), pollInterval, waitForReadyTimeOut); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Elastic Beanstalk Environment (%s) health: %s", d.Id(), err)
+		}
+	}
+
 	err = findEnvironmentErrorsByID(ctx, conn, d.Id(), opTime)
 
 	if err != nil {
@@ -738,6 +846,53 @@ func waitEnvironmentReady(ctx context.Context, conn *elasticbeanstalk.Client, id
 	return nil, err
 }
 
+func statusEnvironmentHealth(ctx context.Context, conn *elasticbeanstalk.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findEnvironmentByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Health), nil
+	}
+}
+
+// waitEnvironmentHealthy waits until the environment's health is at least as good as the specified threshold.
+func waitEnvironmentHealthy(ctx context.Context, conn *elasticbeanstalk.Client, id string, threshold awstypes.EnvironmentHealth, pollInterval, timeout time.Duration) (*awstypes.EnvironmentDescription, error) {
+	target := []awstypes.EnvironmentHealth{awstypes.EnvironmentHealthGreen}
+	if threshold == awstypes.EnvironmentHealthYellow {
+		target = append(target, awstypes.EnvironmentHealthYellow)
+	}
+	var pending []awstypes.EnvironmentHealth
+	for _, v := range enum.EnumValues[awstypes.EnvironmentHealth]() {
+		if !slices.Contains(target, v) {
+			pending = append(pending, v)
+		}
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(pending...),
+		Target:       enum.Slice(target...),
+		Refresh:      statusEnvironmentHealth(ctx, conn, id),
+		Timeout:      timeout,
+		PollInterval: pollInterval,
+		MinTimeout:   3 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.EnvironmentDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitEnvironmentDeleted(ctx context.Context, conn *elasticbeanstalk.Client, id string, pollInterval, timeout time.Duration) (*awstypes.EnvironmentDescription, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:      enum.Slice(awstypes.EnvironmentStatusTerminating),
@@ -872,6 +1027,86 @@ func dropGeneratedSecurityGroup(ctx context.Context, conn *ec2.Client, settingVa
 	return strings.Join(legitGroups, ",")
 }
 
+func customizeDiffDeploymentPolicy(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if v, ok := diff.GetOk("deployment_policy"); !ok || len(v.([]interface{})) == 0 {
+		return nil
+	}
+
+	for _, tfMapRaw := range diff.Get("setting").(*schema.Set).List() {
+		tfMap := tfMapRaw.(map[string]interface{})
+
+		if tfMap[names.AttrNamespace].(string) != optionNamespaceCommand {
+			continue
+		}
+
+		switch name := tfMap[names.AttrName].(string); name {
+		case optionNameBatchSize, optionNameBatchSizeType, optionNameDeploymentPolicy:
+			return fmt.Errorf("setting %s:%s conflicts with deployment_policy", optionNamespaceCommand, name)
+		}
+	}
+
+	return nil
+}
+
+func expandDeploymentPolicyOptionSettings(tfMap map[string]interface{}) []awstypes.ConfigurationOptionSetting {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObjects := []awstypes.ConfigurationOptionSetting{
+		{
+			Namespace:  aws.String(optionNamespaceCommand),
+			OptionName: aws.String(optionNameDeploymentPolicy),
+			Value:      aws.String(tfMap[names.AttrPolicy].(string)),
+		},
+	}
+
+	if v, ok := tfMap["batch_size_type"].(string); ok && v != "" {
+		apiObjects = append(apiObjects, awstypes.ConfigurationOptionSetting{
+			Namespace:  aws.String(optionNamespaceCommand),
+			OptionName: aws.String(optionNameBatchSizeType),
+			Value:      aws.String(v),
+		})
+	}
+
+	if v, ok := tfMap["batch_size"].(int); ok && v != 0 {
+		apiObjects = append(apiObjects, awstypes.ConfigurationOptionSetting{
+			Namespace:  aws.String(optionNamespaceCommand),
+			OptionName: aws.String(optionNameBatchSize),
+			Value:      aws.String(strconv.Itoa(v)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenDeploymentPolicyOptionSettings(apiObjects []awstypes.ConfigurationOptionSetting) []interface{} {
+	tfMap := map[string]interface{}{}
+
+	for _, apiObject := range apiObjects {
+		if aws.ToString(apiObject.Namespace) != optionNamespaceCommand {
+			continue
+		}
+
+		switch value := aws.ToString(apiObject.Value); aws.ToString(apiObject.OptionName) {
+		case optionNameBatchSize:
+			if v, err := strconv.Atoi(value); err == nil {
+				tfMap["batch_size"] = v
+			}
+		case optionNameBatchSizeType:
+			tfMap["batch_size_type"] = value
+		case optionNameDeploymentPolicy:
+			tfMap[names.AttrPolicy] = value
+		}
+	}
+
+	if len(tfMap) == 0 {
+		return nil
+	}
+
+	return []interface{}{tfMap}
+}
+
 func flattenAutoScalingGroups(apiObjects []awstypes.AutoScalingGroup) []string {
 	return tfslices.ApplyToAll(apiObjects, func(v awstypes.AutoScalingGroup) string {
 		return aws.ToString(v.Name)
@@ -497,6 +497,41 @@ func TestAccElasticBeanstalkEnvironment_platformARN(t *testing.T) {
 	})
 }
 
+func TestAccElasticBeanstalkEnvironment_deploymentPolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var app awstypes.EnvironmentDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elastic_beanstalk_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElasticBeanstalkServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfig_deploymentPolicy(rName, "Immutable"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &app),
+					resource.TestCheckResourceAttr(resourceName, "deployment_policy.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "deployment_policy.0.policy", "Immutable"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_health", "Green"),
+				),
+			},
+			{
+				Config: testAccEnvironmentConfig_deploymentPolicy(rName, "RollingWithAdditionalBatch"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &app),
+					resource.TestCheckResourceAttr(resourceName, "deployment_policy.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "deployment_policy.0.policy", "RollingWithAdditionalBatch"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_policy.0.batch_size_type", "Fixed"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_policy.0.batch_size", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckEnvironmentDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticBeanstalkClient(ctx)
@@ -800,6 +835,59 @@ resource "aws_elastic_beanstalk_environment" "test" {
 `, rName))
 }
 
+func testAccEnvironmentConfig_deploymentPolicy(rName, policy string) string {
+	return acctest.ConfigCompose(testAccEnvironmentConfig_base(rName), fmt.Sprintf(`
+resource "aws_elastic_beanstalk_environment" "test" {
+  application         = aws_elastic_beanstalk_application.test.name
+  name                = %[1]q
+  solution_stack_name = data.aws_elastic_beanstalk_solution_stack.test.name
+  wait_for_health     = "Green"
+
+  deployment_policy {
+    policy          = %[2]q
+    batch_size_type = "Fixed"
+    batch_size      = 1
+  }
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "VPCId"
+    value     = aws_vpc.test.id
+  }
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "Subnets"
+    value     = aws_subnet.test[0].id
+  }
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "AssociatePublicIpAddress"
+    value     = "true"
+  }
+
+  setting {
+    namespace = "aws:autoscaling:launchconfiguration"
+    name      = "SecurityGroups"
+    value     = aws_security_group.test.id
+  }
+
+  setting {
+    namespace = "aws:autoscaling:launchconfiguration"
+    name      = "IamInstanceProfile"
+    value     = aws_iam_instance_profile.test.name
+  }
+
+  setting {
+    namespace = "aws:elasticbeanstalk:environment"
+    name      = "ServiceRole"
+    value     = aws_iam_role.service_role.name
+  }
+}
+`, rName, policy))
+}
+
 func testAccEnvironmentConfig_platformARN(rName, platformNameWithVersion string, rValue int) string {
 	return acctest.ConfigCompose(testAccEnvironmentConfig_base(rName), fmt.Sprintf(`
 resource "aws_elastic_beanstalk_environment" "test" {
@@ -125,6 +125,12 @@ const (
 	targetGroupAttributeTargetFailoverOnUnhealthy      = "target_failover.on_unhealthy"
 )
 
+// See https://docs.aws.amazon.com/elasticloadbalancing/latest/APIReference/API_ListenerAttribute.html#API_ListenerAttribute_Contents.
+const (
+	// The following attribute is supported only by Network Load Balancer TCP listeners and Gateway Load Balancer listeners:
+	listenerAttributeTCPIdleTimeoutSeconds = "tcp.idle_timeout.seconds"
+)
+
 const (
 	loadBalancingAlgorithmTypeRoundRobin               = "round_robin"
 	loadBalancingAlgorithmTypeLeastOutstandingRequests = "least_outstanding_requests"
@@ -389,11 +389,18 @@ func resourceListener() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"tcp_idle_timeout_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(60, 6000),
+			},
 		},
 
 		CustomizeDiff: customdiff.All(
 			verify.SetTagsDiff,
 			validateListenerActionsCustomDiff(names.AttrDefaultAction),
+			validateListenerTCPIdleTimeoutCustomDiff,
 		),
 	}
 }
@@ -504,6 +511,15 @@ func resourceListenerCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if v, ok := d.GetOk("tcp_idle_timeout_seconds"); ok {
+		if err := modifyListenerAttributes(ctx, conn, d.Id(), []awstypes.ListenerAttribute{{
+			Key:   aws.String(listenerAttributeTCPIdleTimeoutSeconds),
+			Value: flex.IntValueToString(v.(int)),
+		}}); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return append(diags, resourceListenerRead(ctx, d, meta)...)
 }
 
@@ -544,6 +560,24 @@ func resourceListenerRead(ctx context.Context, d *schema.ResourceData, meta inte
 	d.Set(names.AttrProtocol, listener.Protocol)
 	d.Set("ssl_policy", listener.SslPolicy)
 
+	// Listener attributes are only supported by Network Load Balancer TCP listeners and Gateway Load Balancer listeners.
+	if protocol := listener.Protocol; protocol == awstypes.ProtocolEnumTcp || protocol == awstypes.ProtocolEnumGeneve {
+		attributes, err := findListenerAttributesByARN(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading ELBv2 Listener (%s) attributes: %s", d.Id(), err)
+		}
+
+		for _, v := range attributes {
+			switch aws.ToString(v.Key) {
+			case listenerAttributeTCPIdleTimeoutSeconds:
+				d.Set("tcp_idle_timeout_seconds", flex.StringValueToInt64Value(aws.ToString(v.Value)))
+			}
+		}
+	} else {
+		d.Set("tcp_idle_timeout_seconds", nil)
+	}
+
 	return diags
 }
 
@@ -551,7 +585,7 @@ func resourceListenerUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ELBV2Client(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "tcp_idle_timeout_seconds") {
 		input := &elasticloadbalancingv2.ModifyListenerInput{
 			ListenerArn: aws.String(d.Id()),
 		}
@@ -598,6 +632,15 @@ func resourceListenerUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if d.HasChange("tcp_idle_timeout_seconds") {
+		if err := modifyListenerAttributes(ctx, conn, d.Id(), []awstypes.ListenerAttribute{{
+			Key:   aws.String(listenerAttributeTCPIdleTimeoutSeconds),
+			Value: flex.IntValueToString(d.Get("tcp_idle_timeout_seconds").(int)),
+		}}); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return append(diags, resourceListenerRead(ctx, d, meta)...)
 }
 
@@ -649,6 +692,46 @@ func findListenerByARN(ctx context.Context, conn *elasticloadbalancingv2.Client,
 	return output, nil
 }
 
+func findListenerAttributesByARN(ctx context.Context, conn *elasticloadbalancingv2.Client, arn string) ([]awstypes.ListenerAttribute, error) {
+	input := &elasticloadbalancingv2.DescribeListenerAttributesInput{
+		ListenerArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeListenerAttributes(ctx, input)
+
+	if errs.IsA[*awstypes.ListenerNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Attributes, nil
+}
+
+func modifyListenerAttributes(ctx context.Context, conn *elasticloadbalancingv2.Client, arn string, attributes []awstypes.ListenerAttribute) error {
+	input := &elasticloadbalancingv2.ModifyListenerAttributesInput{
+		Attributes:  attributes,
+		ListenerArn: aws.String(arn),
+	}
+
+	_, err := conn.ModifyListenerAttributes(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("modifying ELBv2 Listener (%s) attributes: %w", arn, err)
+	}
+
+	return nil
+}
+
 func findListener(ctx context.Context, conn *elasticloadbalancingv2.Client, input *elasticloadbalancingv2.DescribeListenersInput, filter tfslices.Predicate[*awstypes.Listener]) (*awstypes.Listener, error) {
 	output, err := findListeners(ctx, conn, input, filter)
 
@@ -1347,6 +1430,30 @@ func validateListenerActionsCustomDiff(attrName string) schema.CustomizeDiffFunc
 	}
 }
 
+// validateListenerTCPIdleTimeoutCustomDiff validates that tcp_idle_timeout_seconds is only configured for TCP listeners.
+// Gateway Load Balancer listeners don't configure a protocol and are left to the API.
+func validateListenerTCPIdleTimeoutCustomDiff(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	configRaw := d.GetRawConfig()
+	if !configRaw.IsKnown() || configRaw.IsNull() {
+		return nil
+	}
+
+	if configRaw.GetAttr("tcp_idle_timeout_seconds").IsNull() {
+		return nil
+	}
+
+	protocol := configRaw.GetAttr(names.AttrProtocol)
+	if !protocol.IsKnown() || protocol.IsNull() {
+		return nil
+	}
+
+	if v := protocol.AsString(); !strings.EqualFold(v, string(awstypes.ProtocolEnumTcp)) {
+		return fmt.Errorf(`tcp_idle_timeout_seconds is only supported for the %q protocol, got %q`, awstypes.ProtocolEnumTcp, v)
+	}
+
+	return nil
+}
+
 func listenerActionsPlantimeValidate(actionsPath cty.Path, actions cty.Value, diags *diag.Diagnostics) {
 	it := actions.ElementIterator()
 	for it.Next() {
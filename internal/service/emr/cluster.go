@@ -650,6 +650,12 @@ func resourceCluster() *schema.Resource {
 					ForceNew: true,
 					Required: true,
 				},
+				"os_release_label": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+					ForceNew: true,
+				},
 				"placement_group_config": {
 					Type:       schema.TypeList,
 					ForceNew:   true,
@@ -966,6 +972,10 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		input.CustomAmiId = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("os_release_label"); ok {
+		input.OSReleaseLabel = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("step_concurrency_level"); ok {
 		input.StepConcurrencyLevel = aws.Int32(int32(v.(int)))
 	}
@@ -1132,6 +1142,7 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 	d.Set("step_concurrency_level", cluster.StepConcurrencyLevel)
 
 	d.Set("custom_ami_id", cluster.CustomAmiId)
+	d.Set("os_release_label", cluster.OSReleaseLabel)
 
 	if err := d.Set("applications", flattenApplications(cluster.Applications)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting applications: %s", err)
@@ -0,0 +1,371 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package gamelift
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/gamelift"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/gamelift/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_gamelift_matchmaking_configuration", name="Matchmaking Configuration")
+// @Tags(identifierAttribute="arn")
+func resourceMatchmakingConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMatchmakingConfigurationCreate,
+		ReadWithoutTimeout:   resourceMatchmakingConfigurationRead,
+		UpdateWithoutTimeout: resourceMatchmakingConfigurationUpdate,
+		DeleteWithoutTimeout: resourceMatchmakingConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"acceptance_required": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"acceptance_timeout_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 600),
+			},
+			"additional_player_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"backfill_mode": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.BackfillMode](),
+			},
+			"custom_event_data": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"flex_match_mode": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.FlexMatchMode](),
+			},
+			"game_property": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 16,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrKey: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 32),
+						},
+						names.AttrValue: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 96),
+						},
+					},
+				},
+			},
+			"game_session_data": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 4096),
+			},
+			"game_session_queue_arns": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"notification_target": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"request_timeout_seconds": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 43200),
+			},
+			"rule_set_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rule_set_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceMatchmakingConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &gamelift.CreateMatchmakingConfigurationInput{
+		AcceptanceRequired:    aws.Bool(d.Get("acceptance_required").(bool)),
+		Name:                  aws.String(name),
+		RequestTimeoutSeconds: aws.Int32(int32(d.Get("request_timeout_seconds").(int))),
+		RuleSetName:           aws.String(d.Get("rule_set_name").(string)),
+		Tags:                  getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("acceptance_timeout_seconds"); ok {
+		input.AcceptanceTimeoutSeconds = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("additional_player_count"); ok {
+		input.AdditionalPlayerCount = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("backfill_mode"); ok {
+		input.BackfillMode = awstypes.BackfillMode(v.(string))
+	}
+
+	if v, ok := d.GetOk("custom_event_data"); ok {
+		input.CustomEventData = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("flex_match_mode"); ok {
+		input.FlexMatchMode = awstypes.FlexMatchMode(v.(string))
+	}
+
+	if v, ok := d.GetOk("game_property"); ok && v.(*schema.Set).Len() > 0 {
+		input.GameProperties = expandGameProperties(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("game_session_data"); ok {
+		input.GameSessionData = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("game_session_queue_arns"); ok && len(v.([]interface{})) > 0 {
+		input.GameSessionQueueArns = flex.ExpandStringValueList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("notification_target"); ok {
+		input.NotificationTarget = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateMatchmakingConfiguration(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating GameLift Matchmaking Configuration (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Configuration.Name))
+
+	return append(diags, resourceMatchmakingConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceMatchmakingConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftClient(ctx)
+
+	configuration, err := findMatchmakingConfigurationByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] GameLift Matchmaking Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading GameLift Matchmaking Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("acceptance_required", configuration.AcceptanceRequired)
+	d.Set("acceptance_timeout_seconds", configuration.AcceptanceTimeoutSeconds)
+	d.Set("additional_player_count", configuration.AdditionalPlayerCount)
+	d.Set(names.AttrARN, configuration.ConfigurationArn)
+	d.Set("backfill_mode", configuration.BackfillMode)
+	d.Set("custom_event_data", configuration.CustomEventData)
+	d.Set(names.AttrDescription, configuration.Description)
+	d.Set("flex_match_mode", configuration.FlexMatchMode)
+	if err := d.Set("game_property", flattenGameProperties(configuration.GameProperties)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting game_property: %s", err)
+	}
+	d.Set("game_session_data", configuration.GameSessionData)
+	d.Set("game_session_queue_arns", configuration.GameSessionQueueArns)
+	d.Set(names.AttrName, configuration.Name)
+	d.Set("notification_target", configuration.NotificationTarget)
+	d.Set("request_timeout_seconds", configuration.RequestTimeoutSeconds)
+	d.Set("rule_set_arn", configuration.RuleSetArn)
+	d.Set("rule_set_name", configuration.RuleSetName)
+
+	return diags
+}
+
+func resourceMatchmakingConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &gamelift.UpdateMatchmakingConfigurationInput{
+			AcceptanceRequired:    aws.Bool(d.Get("acceptance_required").(bool)),
+			AdditionalPlayerCount: aws.Int32(int32(d.Get("additional_player_count").(int))),
+			CustomEventData:       aws.String(d.Get("custom_event_data").(string)),
+			Description:           aws.String(d.Get(names.AttrDescription).(string)),
+			GameProperties:        expandGameProperties(d.Get("game_property").(*schema.Set).List()),
+			GameSessionData:       aws.String(d.Get("game_session_data").(string)),
+			GameSessionQueueArns:  flex.ExpandStringValueList(d.Get("game_session_queue_arns").([]interface{})),
+			Name:                  aws.String(d.Id()),
+			NotificationTarget:    aws.String(d.Get("notification_target").(string)),
+			RequestTimeoutSeconds: aws.Int32(int32(d.Get("request_timeout_seconds").(int))),
+			RuleSetName:           aws.String(d.Get("rule_set_name").(string)),
+		}
+
+		if v, ok := d.GetOk("acceptance_timeout_seconds"); ok {
+			input.AcceptanceTimeoutSeconds = aws.Int32(int32(v.(int)))
+		}
+
+		if v, ok := d.GetOk("backfill_mode"); ok {
+			input.BackfillMode = awstypes.BackfillMode(v.(string))
+		}
+
+		if v, ok := d.GetOk("flex_match_mode"); ok {
+			input.FlexMatchMode = awstypes.FlexMatchMode(v.(string))
+		}
+
+		_, err := conn.UpdateMatchmakingConfiguration(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating GameLift Matchmaking Configuration (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceMatchmakingConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceMatchmakingConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftClient(ctx)
+
+	log.Printf("[INFO] Deleting GameLift Matchmaking Configuration: %s", d.Id())
+	_, err := conn.DeleteMatchmakingConfiguration(ctx, &gamelift.DeleteMatchmakingConfigurationInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting GameLift Matchmaking Configuration (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findMatchmakingConfigurationByName(ctx context.Context, conn *gamelift.Client, name string) (*awstypes.MatchmakingConfiguration, error) {
+	input := &gamelift.DescribeMatchmakingConfigurationsInput{
+		Names: []string{name},
+	}
+
+	output, err := conn.DescribeMatchmakingConfigurations(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return tfresource.AssertSingleValueResult(output.Configurations)
+}
+
+func expandGameProperties(tfList []interface{}) []awstypes.GameProperty {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make([]awstypes.GameProperty, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, awstypes.GameProperty{
+			Key:   aws.String(tfMap[names.AttrKey].(string)),
+			Value: aws.String(tfMap[names.AttrValue].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenGameProperties(apiObjects []awstypes.GameProperty) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrKey:   aws.ToString(apiObject.Key),
+			names.AttrValue: aws.ToString(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,323 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package gamelift_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/gamelift/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgamelift "github.com/hashicorp/terraform-provider-aws/internal/service/gamelift"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccGameLiftMatchmakingConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.MatchmakingConfiguration
+	resourceName := "aws_gamelift_matchmaking_configuration.test"
+	ruleSetResourceName := "aws_gamelift_matchmaking_rule_set.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.GameLiftEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GameLiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMatchmakingConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMatchmakingConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMatchmakingConfigurationExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "acceptance_required", acctest.CtFalse),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "gamelift", regexache.MustCompile(`matchmakingconfiguration/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "flex_match_mode", string(awstypes.FlexMatchModeStandalone)),
+					resource.TestCheckResourceAttr(resourceName, "game_property.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "request_timeout_seconds", "60"),
+					resource.TestCheckResourceAttrPair(resourceName, "rule_set_arn", ruleSetResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "rule_set_name", rName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGameLiftMatchmakingConfiguration_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.MatchmakingConfiguration
+	resourceName := "aws_gamelift_matchmaking_configuration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.GameLiftEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GameLiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMatchmakingConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMatchmakingConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMatchmakingConfigurationExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "acceptance_required", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "request_timeout_seconds", "60"),
+				),
+			},
+			{
+				Config: testAccMatchmakingConfigurationConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMatchmakingConfigurationExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "acceptance_required", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "acceptance_timeout_seconds", "30"),
+					resource.TestCheckResourceAttr(resourceName, "additional_player_count", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "custom_event_data", "custom"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+					resource.TestCheckResourceAttr(resourceName, "game_property.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "game_property.*", map[string]string{
+						names.AttrKey:   "mode",
+						names.AttrValue: "ranked",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "game_session_data", "data"),
+					resource.TestCheckResourceAttr(resourceName, "request_timeout_seconds", "120"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGameLiftMatchmakingConfiguration_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.MatchmakingConfiguration
+	resourceName := "aws_gamelift_matchmaking_configuration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.GameLiftEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GameLiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMatchmakingConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMatchmakingConfigurationConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMatchmakingConfigurationExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMatchmakingConfigurationConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMatchmakingConfigurationExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccMatchmakingConfigurationConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMatchmakingConfigurationExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGameLiftMatchmakingConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.MatchmakingConfiguration
+	resourceName := "aws_gamelift_matchmaking_configuration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.GameLiftEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GameLiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMatchmakingConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMatchmakingConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMatchmakingConfigurationExists(ctx, resourceName, &conf),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfgamelift.ResourceMatchmakingConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMatchmakingConfigurationExists(ctx context.Context, n string, v *awstypes.MatchmakingConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftClient(ctx)
+
+		output, err := tfgamelift.FindMatchmakingConfigurationByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckMatchmakingConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_gamelift_matchmaking_configuration" {
+				continue
+			}
+
+			_, err := tfgamelift.FindMatchmakingConfigurationByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("GameLift Matchmaking Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccMatchmakingConfigurationConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_gamelift_matchmaking_rule_set" "test" {
+  name = %[1]q
+
+  rule_set_body = jsonencode({
+    name                = "test"
+    ruleLanguageVersion = "1.0"
+    teams = [{
+      name       = "alpha"
+      minPlayers = 1
+      maxPlayers = 5
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccMatchmakingConfigurationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccMatchmakingConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_gamelift_matchmaking_configuration" "test" {
+  name                    = %[1]q
+  acceptance_required     = false
+  flex_match_mode         = "STANDALONE"
+  request_timeout_seconds = 60
+  rule_set_name           = aws_gamelift_matchmaking_rule_set.test.name
+}
+`, rName))
+}
+
+func testAccMatchmakingConfigurationConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccMatchmakingConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_gamelift_matchmaking_configuration" "test" {
+  name                       = %[1]q
+  acceptance_required        = true
+  acceptance_timeout_seconds = 30
+  additional_player_count    = 2
+  custom_event_data          = "custom"
+  description                = "updated"
+  flex_match_mode            = "STANDALONE"
+  game_session_data          = "data"
+  request_timeout_seconds    = 120
+  rule_set_name              = aws_gamelift_matchmaking_rule_set.test.name
+
+  game_property {
+    key   = "mode"
+    value = "ranked"
+  }
+}
+`, rName))
+}
+
+func testAccMatchmakingConfigurationConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccMatchmakingConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_gamelift_matchmaking_configuration" "test" {
+  name                    = %[1]q
+  acceptance_required     = false
+  flex_match_mode         = "STANDALONE"
+  request_timeout_seconds = 60
+  rule_set_name           = aws_gamelift_matchmaking_rule_set.test.name
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccMatchmakingConfigurationConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccMatchmakingConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_gamelift_matchmaking_configuration" "test" {
+  name                    = %[1]q
+  acceptance_required     = false
+  flex_match_mode         = "STANDALONE"
+  request_timeout_seconds = 60
+  rule_set_name           = aws_gamelift_matchmaking_rule_set.test.name
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
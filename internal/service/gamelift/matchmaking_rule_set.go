@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package gamelift
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/gamelift"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/gamelift/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_gamelift_matchmaking_rule_set", name="Matchmaking Rule Set")
+// @Tags(identifierAttribute="arn")
+func resourceMatchmakingRuleSet() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMatchmakingRuleSetCreate,
+		ReadWithoutTimeout:   resourceMatchmakingRuleSetRead,
+		UpdateWithoutTimeout: resourceMatchmakingRuleSetUpdate,
+		DeleteWithoutTimeout: resourceMatchmakingRuleSetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"rule_set_body": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.All(validation.StringLenBetween(1, 65535), validation.StringIsJSON),
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceMatchmakingRuleSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &gamelift.CreateMatchmakingRuleSetInput{
+		Name:        aws.String(name),
+		RuleSetBody: aws.String(d.Get("rule_set_body").(string)),
+		Tags:        getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateMatchmakingRuleSet(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating GameLift Matchmaking Rule Set (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.RuleSet.RuleSetName))
+
+	return append(diags, resourceMatchmakingRuleSetRead(ctx, d, meta)...)
+}
+
+func resourceMatchmakingRuleSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftClient(ctx)
+
+	ruleSet, err := findMatchmakingRuleSetByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] GameLift Matchmaking Rule Set (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading GameLift Matchmaking Rule Set (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, ruleSet.RuleSetArn)
+	d.Set(names.AttrName, ruleSet.RuleSetName)
+	d.Set("rule_set_body", ruleSet.RuleSetBody)
+
+	return diags
+}
+
+func resourceMatchmakingRuleSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Tags only.
+
+	return append(diags, resourceMatchmakingRuleSetRead(ctx, d, meta)...)
+}
+
+func resourceMatchmakingRuleSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftClient(ctx)
+
+	log.Printf("[INFO] Deleting GameLift Matchmaking Rule Set: %s", d.Id())
+	_, err := conn.DeleteMatchmakingRuleSet(ctx, &gamelift.DeleteMatchmakingRuleSetInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting GameLift Matchmaking Rule Set (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findMatchmakingRuleSetByName(ctx context.Context, conn *gamelift.Client, name string) (*awstypes.MatchmakingRuleSet, error) {
+	input := &gamelift.DescribeMatchmakingRuleSetsInput{
+		Names: []string{name},
+	}
+
+	output, err := conn.DescribeMatchmakingRuleSets(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return tfresource.AssertSingleValueResult(output.RuleSets)
+}
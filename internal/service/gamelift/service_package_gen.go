@@ -68,6 +68,22 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  resourceMatchmakingConfiguration,
+			TypeName: "aws_gamelift_matchmaking_configuration",
+			Name:     "Matchmaking Configuration",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  resourceMatchmakingRuleSet,
+			TypeName: "aws_gamelift_matchmaking_rule_set",
+			Name:     "Matchmaking Rule Set",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  resourceScript,
 			TypeName: "aws_gamelift_script",
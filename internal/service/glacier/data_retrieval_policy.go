@@ -0,0 +1,227 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glacier
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glacier"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/glacier/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	dataRetrievalStrategyBytesPerHour = "BytesPerHour"
+	dataRetrievalStrategyFreeTier     = "FreeTier"
+	dataRetrievalStrategyNone         = "None"
+)
+
+func dataRetrievalStrategy_Values() []string {
+	return []string{
+		dataRetrievalStrategyBytesPerHour,
+		dataRetrievalStrategyFreeTier,
+		dataRetrievalStrategyNone,
+	}
+}
+
+// @FrameworkResource("aws_glacier_data_retrieval_policy", name="Data Retrieval Policy")
+func newDataRetrievalPolicyResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &dataRetrievalPolicyResource{}
+
+	return r, nil
+}
+
+type dataRetrievalPolicyResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*dataRetrievalPolicyResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_glacier_data_retrieval_policy"
+}
+
+func (r *dataRetrievalPolicyResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"bytes_per_hour": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"strategy": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(dataRetrievalStrategy_Values()...),
+				},
+			},
+		},
+	}
+}
+
+func (r *dataRetrievalPolicyResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data dataRetrievalPolicyResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Strategy.IsUnknown() || data.BytesPerHour.IsUnknown() {
+		return
+	}
+
+	switch strategy := data.Strategy.ValueString(); strategy {
+	case dataRetrievalStrategyBytesPerHour:
+		if data.BytesPerHour.IsNull() {
+			response.Diagnostics.Append(fwdiag.NewAttributeRequiredWhenError(path.Root("bytes_per_hour"), path.Root("strategy"), strategy))
+		}
+	default:
+		if !data.BytesPerHour.IsNull() {
+			response.Diagnostics.Append(fwdiag.NewAttributeConflictsWhenError(path.Root("bytes_per_hour"), path.Root("strategy"), strategy))
+		}
+	}
+}
+
+func (r *dataRetrievalPolicyResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data dataRetrievalPolicyResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GlacierClient(ctx)
+
+	id := r.Meta().AccountID
+	if err := putDataRetrievalPolicy(ctx, conn, data.Strategy.ValueString(), fwflex.Int64FromFramework(ctx, data.BytesPerHour)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Glacier Data Retrieval Policy (%s)", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringValueToFramework(ctx, id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *dataRetrievalPolicyResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data dataRetrievalPolicyResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GlacierClient(ctx)
+
+	rule, err := findDataRetrievalRule(ctx, conn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Glacier Data Retrieval Policy (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.BytesPerHour = fwflex.Int64ToFramework(ctx, rule.BytesPerHour)
+	data.Strategy = fwflex.StringToFramework(ctx, rule.Strategy)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *dataRetrievalPolicyResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new dataRetrievalPolicyResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GlacierClient(ctx)
+
+	if err := putDataRetrievalPolicy(ctx, conn, new.Strategy.ValueString(), fwflex.Int64FromFramework(ctx, new.BytesPerHour)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Glacier Data Retrieval Policy (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *dataRetrievalPolicyResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data dataRetrievalPolicyResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GlacierClient(ctx)
+
+	// Revert to the default policy.
+	if err := putDataRetrievalPolicy(ctx, conn, dataRetrievalStrategyFreeTier, nil); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Glacier Data Retrieval Policy (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func putDataRetrievalPolicy(ctx context.Context, conn *glacier.Client, strategy string, bytesPerHour *int64) error {
+	input := &glacier.SetDataRetrievalPolicyInput{
+		AccountId: aws.String("-"),
+		Policy: &awstypes.DataRetrievalPolicy{
+			Rules: []awstypes.DataRetrievalRule{
+				{
+					BytesPerHour: bytesPerHour,
+					Strategy:     aws.String(strategy),
+				},
+			},
+		},
+	}
+
+	_, err := conn.SetDataRetrievalPolicy(ctx, input)
+
+	return err
+}
+
+func findDataRetrievalRule(ctx context.Context, conn *glacier.Client) (*awstypes.DataRetrievalRule, error) {
+	input := &glacier.GetDataRetrievalPolicyInput{
+		AccountId: aws.String("-"),
+	}
+
+	output, err := conn.GetDataRetrievalPolicy(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Policy == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return tfresource.AssertSingleValueResult(output.Policy.Rules)
+}
+
+type dataRetrievalPolicyResourceModel struct {
+	BytesPerHour types.Int64  `tfsdk:"bytes_per_hour"`
+	ID           types.String `tfsdk:"id"`
+	Strategy     types.String `tfsdk:"strategy"`
+}
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glacier_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfglacier "github.com/hashicorp/terraform-provider-aws/internal/service/glacier"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// The data retrieval policy is an account-level setting, so tests must not run in parallel.
+func TestAccGlacierDataRetrievalPolicy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_glacier_data_retrieval_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlacierServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataRetrievalPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataRetrievalPolicyConfig_strategy("None"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataRetrievalPolicyExists(ctx, resourceName),
+					resource.TestCheckNoResourceAttr(resourceName, "bytes_per_hour"),
+					resource.TestCheckResourceAttr(resourceName, "strategy", "None"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDataRetrievalPolicyConfig_bytesPerHour(10737418240),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataRetrievalPolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "bytes_per_hour", "10737418240"),
+					resource.TestCheckResourceAttr(resourceName, "strategy", "BytesPerHour"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGlacierDataRetrievalPolicy_validation(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlacierServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataRetrievalPolicyConfig_strategy("BytesPerHour"),
+				ExpectError: regexache.MustCompile(`Attribute "bytes_per_hour" must be specified when "strategy" is "BytesPerHour"`),
+			},
+		},
+	})
+}
+
+func testAccCheckDataRetrievalPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlacierClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_glacier_data_retrieval_policy" {
+				continue
+			}
+
+			output, err := tfglacier.FindDataRetrievalRule(ctx, conn)
+
+			if err != nil {
+				return err
+			}
+
+			if strategy := aws.ToString(output.Strategy); strategy != "FreeTier" {
+				return fmt.Errorf("Glacier Data Retrieval Policy %s still exists with strategy %s", rs.Primary.ID, strategy)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDataRetrievalPolicyExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlacierClient(ctx)
+
+		_, err := tfglacier.FindDataRetrievalRule(ctx, conn)
+
+		return err
+	}
+}
+
+func testAccDataRetrievalPolicyConfig_strategy(strategy string) string {
+	return fmt.Sprintf(`
+resource "aws_glacier_data_retrieval_policy" "test" {
+  strategy = %[1]q
+}
+`, strategy)
+}
+
+func testAccDataRetrievalPolicyConfig_bytesPerHour(bytesPerHour int) string {
+	return fmt.Sprintf(`
+resource "aws_glacier_data_retrieval_policy" "test" {
+  strategy       = "BytesPerHour"
+  bytes_per_hour = %[1]d
+}
+`, bytesPerHour)
+}
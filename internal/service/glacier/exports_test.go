@@ -5,9 +5,11 @@ package glacier
 
 // Exports for use in tests only.
 var (
-	ResourceVault     = resourceVault
-	ResourceVaultLock = resourceVaultLock
+	ResourceDataRetrievalPolicy = newDataRetrievalPolicyResource
+	ResourceVault               = resourceVault
+	ResourceVaultLock           = resourceVaultLock
 
-	FindVaultByName     = findVaultByName
-	FindVaultLockByName = findVaultLockByName
+	FindDataRetrievalRule = findDataRetrievalRule
+	FindVaultByName       = findVaultByName
+	FindVaultLockByName   = findVaultLockByName
 )
@@ -0,0 +1,498 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package globalaccelerator
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/globalaccelerator/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	customRoutingEndpointTrafficResourceIDPartCount = 2
+)
+
+// @FrameworkResource("aws_globalaccelerator_custom_routing_endpoint_traffic", name="Custom Routing Endpoint Traffic")
+func newCustomRoutingEndpointTrafficResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &customRoutingEndpointTrafficResource{}
+
+	return r, nil
+}
+
+type customRoutingEndpointTrafficResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+}
+
+func (*customRoutingEndpointTrafficResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_globalaccelerator_custom_routing_endpoint_traffic"
+}
+
+func (r *customRoutingEndpointTrafficResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"allow_all_traffic_to_endpoint": schema.BoolAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"destination_addresses": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"destination_ports": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"endpoint_group_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"endpoint_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+		},
+	}
+}
+
+func (r *customRoutingEndpointTrafficResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data customRoutingEndpointTrafficResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.AllowAllTrafficToEndpoint.IsUnknown() {
+		return
+	}
+
+	if !data.AllowAllTrafficToEndpoint.ValueBool() {
+		if data.DestinationAddresses.IsNull() {
+			response.Diagnostics.Append(fwdiag.NewAttributeRequiredWhenError(path.Root("destination_addresses"), path.Root("allow_all_traffic_to_endpoint"), "false"))
+		}
+
+		return
+	}
+
+	// Destinations cannot be specified when all traffic to the endpoint is allowed.
+	if !data.DestinationAddresses.IsNull() {
+		response.Diagnostics.Append(fwdiag.NewAttributeConflictsWhenError(path.Root("destination_addresses"), path.Root("allow_all_traffic_to_endpoint"), "true"))
+	}
+	if !data.DestinationPorts.IsNull() {
+		response.Diagnostics.Append(fwdiag.NewAttributeConflictsWhenError(path.Root("destination_ports"), path.Root("allow_all_traffic_to_endpoint"), "true"))
+	}
+}
+
+func (r *customRoutingEndpointTrafficResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data customRoutingEndpointTrafficResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GlobalAcceleratorClient(ctx)
+
+	endpointGroupARN, endpointID := data.EndpointGroupARN.ValueString(), data.EndpointID.ValueString()
+	id, err := flex.FlattenResourceId([]string{endpointGroupARN, endpointID}, customRoutingEndpointTrafficResourceIDPartCount, false)
+	if err != nil {
+		response.Diagnostics.AddError("creating Global Accelerator Custom Routing Endpoint Traffic", err.Error())
+
+		return
+	}
+
+	input := &globalaccelerator.AllowCustomRoutingTrafficInput{
+		AllowAllTrafficToEndpoint: fwflex.BoolFromFramework(ctx, data.AllowAllTrafficToEndpoint),
+		DestinationAddresses:      fwflex.ExpandFrameworkStringValueSet(ctx, data.DestinationAddresses),
+		DestinationPorts:          fwflex.ExpandFrameworkInt32ValueSet(ctx, data.DestinationPorts),
+		EndpointGroupArn:          aws.String(endpointGroupARN),
+		EndpointId:                aws.String(endpointID),
+	}
+
+	_, err = conn.AllowCustomRoutingTraffic(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Global Accelerator Custom Routing Endpoint Traffic (%s)", id), err.Error())
+
+		return
+	}
+
+	acceleratorARN, err := listenerOrEndpointGroupARNToAcceleratorARN(endpointGroupARN)
+	if err != nil {
+		response.Diagnostics.AddError("parsing endpoint group ARN", err.Error())
+
+		return
+	}
+
+	if _, err := waitCustomRoutingAcceleratorDeployed(ctx, conn, acceleratorARN, customRoutingEndpointTrafficTimeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Global Accelerator Custom Routing Accelerator (%s) deploy", acceleratorARN), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringValueToFramework(ctx, id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *customRoutingEndpointTrafficResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data customRoutingEndpointTrafficResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GlobalAcceleratorClient(ctx)
+
+	endpointGroupARN, endpointID := data.EndpointGroupARN.ValueString(), data.EndpointID.ValueString()
+	var err error
+
+	switch {
+	case data.AllowAllTrafficToEndpoint.ValueBool():
+		err = findCustomRoutingEndpointAllTrafficAllowed(ctx, conn, endpointGroupARN, endpointID)
+	case !data.DestinationAddresses.IsNull():
+		err = findCustomRoutingEndpointDestinationTrafficAllowed(ctx, conn, endpointGroupARN, endpointID, fwflex.ExpandFrameworkStringValueSet(ctx, data.DestinationAddresses), fwflex.ExpandFrameworkInt32ValueSet(ctx, data.DestinationPorts))
+	default:
+		// Imported: derive the allowed destinations from the endpoint's port mappings.
+		var portMappings []awstypes.PortMapping
+		portMappings, err = findCustomRoutingPortMappingsByTwoPartKey(ctx, conn, endpointGroupARN, endpointID)
+
+		if err == nil {
+			response.Diagnostics.Append(data.setDestinationsFromPortMappings(ctx, portMappings)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			if data.AllowAllTrafficToEndpoint.IsNull() && data.DestinationAddresses.IsNull() {
+				err = tfresource.NewEmptyResultError(nil)
+			}
+		}
+	}
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Global Accelerator Custom Routing Endpoint Traffic (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *customRoutingEndpointTrafficResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data customRoutingEndpointTrafficResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GlobalAcceleratorClient(ctx)
+
+	endpointGroupARN := data.EndpointGroupARN.ValueString()
+	_, err := conn.DenyCustomRoutingTraffic(ctx, &globalaccelerator.DenyCustomRoutingTrafficInput{
+		DenyAllTrafficToEndpoint: fwflex.BoolFromFramework(ctx, data.AllowAllTrafficToEndpoint),
+		DestinationAddresses:     fwflex.ExpandFrameworkStringValueSet(ctx, data.DestinationAddresses),
+		DestinationPorts:         fwflex.ExpandFrameworkInt32ValueSet(ctx, data.DestinationPorts),
+		EndpointGroupArn:         aws.String(endpointGroupARN),
+		EndpointId:               aws.String(data.EndpointID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.EndpointGroupNotFoundException](err) || errs.IsA[*awstypes.EndpointNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Global Accelerator Custom Routing Endpoint Traffic (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	acceleratorARN, err := listenerOrEndpointGroupARNToAcceleratorARN(endpointGroupARN)
+	if err != nil {
+		response.Diagnostics.AddError("parsing endpoint group ARN", err.Error())
+
+		return
+	}
+
+	if _, err := waitCustomRoutingAcceleratorDeployed(ctx, conn, acceleratorARN, customRoutingEndpointTrafficTimeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Global Accelerator Custom Routing Accelerator (%s) deploy", acceleratorARN), err.Error())
+
+		return
+	}
+}
+
+func (r *customRoutingEndpointTrafficResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	parts, err := flex.ExpandResourceId(request.ID, customRoutingEndpointTrafficResourceIDPartCount, false)
+	if err != nil {
+		response.Diagnostics.AddError("importing Global Accelerator Custom Routing Endpoint Traffic", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("endpoint_group_arn"), parts[0])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("endpoint_id"), parts[1])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), request.ID)...)
+}
+
+const (
+	customRoutingEndpointTrafficTimeout = 30 * time.Minute
+)
+
+// findCustomRoutingPortMappingsByTwoPartKey returns all the port mappings for the specified endpoint.
+func findCustomRoutingPortMappingsByTwoPartKey(ctx context.Context, conn *globalaccelerator.Client, endpointGroupARN, endpointID string) ([]awstypes.PortMapping, error) {
+	acceleratorARN, err := listenerOrEndpointGroupARNToAcceleratorARN(endpointGroupARN)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &globalaccelerator.ListCustomRoutingPortMappingsInput{
+		AcceleratorArn:   aws.String(acceleratorARN),
+		EndpointGroupArn: aws.String(endpointGroupARN),
+	}
+	var output []awstypes.PortMapping
+
+	pages := globalaccelerator.NewListCustomRoutingPortMappingsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.AcceleratorNotFoundException](err) || errs.IsA[*awstypes.EndpointGroupNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.PortMappings {
+			if aws.ToString(v.EndpointId) == endpointID {
+				output = append(output, v)
+			}
+		}
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// findCustomRoutingDestinationPortMappingsByThreePartKey returns the port mappings for the specified destination address in the specified endpoint.
+func findCustomRoutingDestinationPortMappingsByThreePartKey(ctx context.Context, conn *globalaccelerator.Client, endpointGroupARN, endpointID, destinationAddress string) ([]awstypes.DestinationPortMapping, error) {
+	input := &globalaccelerator.ListCustomRoutingPortMappingsByDestinationInput{
+		DestinationAddress: aws.String(destinationAddress),
+		EndpointId:         aws.String(endpointID),
+	}
+	var output []awstypes.DestinationPortMapping
+
+	pages := globalaccelerator.NewListCustomRoutingPortMappingsByDestinationPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.EndpointNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.DestinationPortMappings {
+			if aws.ToString(v.EndpointGroupArn) == endpointGroupARN {
+				output = append(output, v)
+			}
+		}
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// findCustomRoutingEndpointAllTrafficAllowed returns a NotFound error unless every destination in the specified endpoint is allowed to receive traffic.
+func findCustomRoutingEndpointAllTrafficAllowed(ctx context.Context, conn *globalaccelerator.Client, endpointGroupARN, endpointID string) error {
+	portMappings, err := findCustomRoutingPortMappingsByTwoPartKey(ctx, conn, endpointGroupARN, endpointID)
+
+	if err != nil {
+		return err
+	}
+
+	for _, v := range portMappings {
+		if v.DestinationTrafficState != awstypes.CustomRoutingDestinationTrafficStateAllow {
+			return &retry.NotFoundError{
+				Message: fmt.Sprintf("destination %s:%d is not allowed to receive traffic", aws.ToString(v.DestinationSocketAddress.IpAddress), aws.ToInt32(v.DestinationSocketAddress.Port)),
+			}
+		}
+	}
+
+	return nil
+}
+
+// findCustomRoutingEndpointDestinationTrafficAllowed returns a NotFound error unless every specified destination address and port in the specified endpoint is allowed to receive traffic.
+// If no ports are specified, all ports for each destination address must be allowed.
+func findCustomRoutingEndpointDestinationTrafficAllowed(ctx context.Context, conn *globalaccelerator.Client, endpointGroupARN, endpointID string, destinationAddresses []string, destinationPorts []int32) error {
+	for _, address := range destinationAddresses {
+		portMappings, err := findCustomRoutingDestinationPortMappingsByThreePartKey(ctx, conn, endpointGroupARN, endpointID, address)
+
+		if err != nil {
+			return err
+		}
+
+		allowedPorts := make(map[int32]bool)
+		for _, v := range portMappings {
+			port := aws.ToInt32(v.DestinationSocketAddress.Port)
+
+			if len(destinationPorts) > 0 && !slices.Contains(destinationPorts, port) {
+				continue
+			}
+
+			if v.DestinationTrafficState != awstypes.CustomRoutingDestinationTrafficStateAllow {
+				return &retry.NotFoundError{
+					Message: fmt.Sprintf("destination %s:%d is not allowed to receive traffic", address, port),
+				}
+			}
+
+			allowedPorts[port] = true
+		}
+
+		for _, port := range destinationPorts {
+			if !allowedPorts[port] {
+				return &retry.NotFoundError{
+					Message: fmt.Sprintf("destination %s:%d is not allowed to receive traffic", address, port),
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+type customRoutingEndpointTrafficResourceModel struct {
+	AllowAllTrafficToEndpoint types.Bool                       `tfsdk:"allow_all_traffic_to_endpoint"`
+	DestinationAddresses      fwtypes.SetValueOf[types.String] `tfsdk:"destination_addresses"`
+	DestinationPorts          types.Set                        `tfsdk:"destination_ports"`
+	EndpointGroupARN          fwtypes.ARN                      `tfsdk:"endpoint_group_arn"`
+	EndpointID                types.String                     `tfsdk:"endpoint_id"`
+	ID                        types.String                     `tfsdk:"id"`
+}
+
+// setDestinationsFromPortMappings sets the allowed destinations from the endpoint's port mappings.
+// Destination ports are only set when some ports of the allowed destination addresses are denied traffic.
+func (data *customRoutingEndpointTrafficResourceModel) setDestinationsFromPortMappings(ctx context.Context, portMappings []awstypes.PortMapping) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	allowed := make(map[string][]int32)
+	allowAll := true
+	for _, v := range portMappings {
+		if v.DestinationTrafficState != awstypes.CustomRoutingDestinationTrafficStateAllow {
+			allowAll = false
+			continue
+		}
+
+		address := aws.ToString(v.DestinationSocketAddress.IpAddress)
+		allowed[address] = append(allowed[address], aws.ToInt32(v.DestinationSocketAddress.Port))
+	}
+
+	if allowAll {
+		data.AllowAllTrafficToEndpoint = types.BoolValue(true)
+
+		return diags
+	}
+
+	if len(allowed) == 0 {
+		return diags
+	}
+
+	var addresses []string
+	var ports []int32
+	allPorts := true
+	for _, v := range portMappings {
+		address, port := aws.ToString(v.DestinationSocketAddress.IpAddress), aws.ToInt32(v.DestinationSocketAddress.Port)
+
+		if _, ok := allowed[address]; !ok {
+			continue
+		}
+
+		if !slices.Contains(allowed[address], port) {
+			allPorts = false
+		} else if !slices.Contains(ports, port) {
+			ports = append(ports, port)
+		}
+
+		if !slices.Contains(addresses, address) {
+			addresses = append(addresses, address)
+		}
+	}
+
+	diags.Append(fwflex.Flatten(ctx, addresses, &data.DestinationAddresses)...)
+	if !allPorts {
+		data.DestinationPorts = fwflex.FlattenFrameworkInt32ValueSet(ctx, ports)
+	}
+
+	return diags
+}
@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package globalaccelerator_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfglobalaccelerator "github.com/hashicorp/terraform-provider-aws/internal/service/globalaccelerator"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccGlobalAcceleratorCustomRoutingEndpointTraffic_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_globalaccelerator_custom_routing_endpoint_traffic.test"
+	endpointGroupResourceName := "aws_globalaccelerator_custom_routing_endpoint_group.test"
+	subnetResourceName := "aws_subnet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlobalAcceleratorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomRoutingEndpointTrafficDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomRoutingEndpointTrafficConfig_allowAll(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCustomRoutingEndpointTrafficExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "allow_all_traffic_to_endpoint", acctest.CtTrue),
+					resource.TestCheckNoResourceAttr(resourceName, "destination_addresses"),
+					resource.TestCheckNoResourceAttr(resourceName, "destination_ports"),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint_group_arn", endpointGroupResourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint_id", subnetResourceName, names.AttrID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGlobalAcceleratorCustomRoutingEndpointTraffic_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_globalaccelerator_custom_routing_endpoint_traffic.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlobalAcceleratorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomRoutingEndpointTrafficDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomRoutingEndpointTrafficConfig_allowAll(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomRoutingEndpointTrafficExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfglobalaccelerator.ResourceCustomRoutingEndpointTraffic, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGlobalAcceleratorCustomRoutingEndpointTraffic_destinations(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_globalaccelerator_custom_routing_endpoint_traffic.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlobalAcceleratorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomRoutingEndpointTrafficDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomRoutingEndpointTrafficConfig_destinations(rName, "10.0.0.4", 8080),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCustomRoutingEndpointTrafficExists(ctx, resourceName),
+					resource.TestCheckNoResourceAttr(resourceName, "allow_all_traffic_to_endpoint"),
+					resource.TestCheckResourceAttr(resourceName, "destination_addresses.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "destination_addresses.*", "10.0.0.4"),
+					resource.TestCheckResourceAttr(resourceName, "destination_ports.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "destination_ports.*", "8080"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCustomRoutingEndpointTrafficConfig_destinations(rName, "10.0.0.5", 8081),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCustomRoutingEndpointTrafficExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "destination_addresses.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "destination_addresses.*", "10.0.0.5"),
+					resource.TestCheckResourceAttr(resourceName, "destination_ports.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "destination_ports.*", "8081"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCustomRoutingEndpointTrafficDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlobalAcceleratorClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_globalaccelerator_custom_routing_endpoint_traffic" {
+				continue
+			}
+
+			err := testAccFindCustomRoutingEndpointTrafficAllowed(ctx, conn, rs)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Global Accelerator Custom Routing Endpoint Traffic %s still exists", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckCustomRoutingEndpointTrafficExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlobalAcceleratorClient(ctx)
+
+		return testAccFindCustomRoutingEndpointTrafficAllowed(ctx, conn, rs)
+	}
+}
+
+func testAccFindCustomRoutingEndpointTrafficAllowed(ctx context.Context, conn *globalaccelerator.Client, rs *terraform.ResourceState) error {
+	endpointGroupARN, endpointID := rs.Primary.Attributes["endpoint_group_arn"], rs.Primary.Attributes["endpoint_id"]
+
+	if rs.Primary.Attributes["allow_all_traffic_to_endpoint"] == acctest.CtTrue {
+		return tfglobalaccelerator.FindCustomRoutingEndpointAllTrafficAllowed(ctx, conn, endpointGroupARN, endpointID)
+	}
+
+	var addresses []string
+	var ports []int32
+	for k, v := range rs.Primary.Attributes {
+		switch {
+		case strings.HasSuffix(k, ".#"):
+		case strings.HasPrefix(k, "destination_addresses."):
+			addresses = append(addresses, v)
+		case strings.HasPrefix(k, "destination_ports."):
+			port, err := strconv.ParseInt(v, 10, 32)
+			if err != nil {
+				return err
+			}
+			ports = append(ports, int32(port))
+		}
+	}
+
+	return tfglobalaccelerator.FindCustomRoutingEndpointDestinationTrafficAllowed(ctx, conn, endpointGroupARN, endpointID, addresses, ports)
+}
+
+func testAccCustomRoutingEndpointTrafficConfig_allowAll(rName string) string {
+	return acctest.ConfigCompose(testAccCustomRoutingEndpointGroupConfig_endpointConfiguration(rName), `
+resource "aws_globalaccelerator_custom_routing_endpoint_traffic" "test" {
+  endpoint_group_arn = aws_globalaccelerator_custom_routing_endpoint_group.test.id
+  endpoint_id        = aws_subnet.test.id
+
+  allow_all_traffic_to_endpoint = true
+}
+`)
+}
+
+func testAccCustomRoutingEndpointTrafficConfig_destinations(rName, address string, port int) string {
+	return acctest.ConfigCompose(testAccCustomRoutingEndpointGroupConfig_endpointConfiguration(rName), fmt.Sprintf(`
+resource "aws_globalaccelerator_custom_routing_endpoint_traffic" "test" {
+  endpoint_group_arn = aws_globalaccelerator_custom_routing_endpoint_group.test.id
+  endpoint_id        = aws_subnet.test.id
+
+  destination_addresses = [%[1]q]
+  destination_ports     = [%[2]d]
+}
+`, address, port))
+}
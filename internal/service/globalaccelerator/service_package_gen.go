@@ -30,6 +30,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory: newCustomRoutingEndpointTrafficResource,
+			Name:    "Custom Routing Endpoint Traffic",
+		},
 	}
 }
 
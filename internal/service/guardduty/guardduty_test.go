@@ -5,7 +5,6 @@ package guardduty_test
 
 import (
 	"context"
-	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -83,24 +82,6 @@ func TestAccGuardDuty_serial(t *testing.T) {
 	acctest.RunSerialTests2Levels(t, testCases, 0)
 }
 
-func testAccMemberFromEnv(t *testing.T) (string, string) {
-	accountID := os.Getenv("AWS_GUARDDUTY_MEMBER_ACCOUNT_ID")
-	if accountID == "" {
-		t.Skip(
-			"Environment variable AWS_GUARDDUTY_MEMBER_ACCOUNT_ID is not set. " +
-				"To properly test inviting GuardDuty member accounts, " +
-				"a valid AWS account ID must be provided.")
-	}
-	email := os.Getenv("AWS_GUARDDUTY_MEMBER_EMAIL")
-	if email == "" {
-		t.Skip(
-			"Environment variable AWS_GUARDDUTY_MEMBER_EMAIL is not set. " +
-				"To properly test inviting GuardDuty member accounts, " +
-				"a valid email associated with the AWS_GUARDDUTY_MEMBER_ACCOUNT_ID must be provided.")
-	}
-	return accountID, email
-}
-
 // testAccPreCheckDetectorExists verifies the current account has a single active GuardDuty detector configured.
 func testAccPreCheckDetectorExists(ctx context.Context, t *testing.T) {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).GuardDutyClient(ctx)
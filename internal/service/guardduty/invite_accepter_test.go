@@ -24,20 +24,19 @@ func testAccInviteAccepter_basic(t *testing.T) {
 	masterDetectorResourceName := "aws_guardduty_detector.master"
 	memberDetectorResourceName := "aws_guardduty_detector.member"
 	resourceName := "aws_guardduty_invite_accepter.test"
-	_, email := testAccMemberFromEnv(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
-			acctest.PreCheckAlternateAccount(t)
+			acctest.PreCheckMultipleAccount(t, 2)
 			testAccPreCheckDetectorNotExists(ctx, t)
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.GuardDutyServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesMultipleAccounts(ctx, t, 2),
 		CheckDestroy:             testAccCheckInviteAccepterDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccInviteAccepterConfig_basic(email),
+				Config: testAccInviteAccepterConfig_basic(),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckInviteAccepterExists(ctx, resourceName),
 					resource.TestCheckResourceAttrPair(resourceName, "detector_id", memberDetectorResourceName, names.AttrID),
@@ -45,7 +44,7 @@ func testAccInviteAccepter_basic(t *testing.T) {
 				),
 			},
 			{
-				Config:            testAccInviteAccepterConfig_basic(email),
+				Config:            testAccInviteAccepterConfig_basic(),
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
@@ -119,8 +118,8 @@ func testAccCheckInviteAccepterExists(ctx context.Context, resourceName string)
 	}
 }
 
-func testAccInviteAccepterConfig_basic(email string) string {
-	return acctest.ConfigAlternateAccountProvider() + fmt.Sprintf(`
+func testAccInviteAccepterConfig_basic() string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
 resource "aws_guardduty_detector" "master" {
   provider = "awsalternate"
 }
@@ -143,5 +142,5 @@ resource "aws_guardduty_invite_accepter" "test" {
   detector_id       = aws_guardduty_detector.member.id
   master_account_id = aws_guardduty_detector.master.account_id
 }
-`, email)
+`, acctest.DefaultEmailAddress))
 }
@@ -56,28 +56,30 @@ func testAccMember_basic(t *testing.T) {
 func testAccMember_invite_disassociate(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_guardduty_member.test"
-	accountID, email := testAccMemberFromEnv(t)
+	memberDataSourceName := "data.aws_caller_identity.member"
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleAccount(t, 2)
 			testAccPreCheckDetectorNotExists(ctx, t)
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.GuardDutyServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesMultipleAccounts(ctx, t, 2),
 		CheckDestroy:             testAccCheckMemberDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccMemberConfig_invite(accountID, email, true),
+				Config: testAccMemberConfig_invite(true),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckMemberExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrAccountID, memberDataSourceName, names.AttrAccountID),
 					resource.TestCheckResourceAttr(resourceName, "invite", acctest.CtTrue),
 					resource.TestCheckResourceAttr(resourceName, "relationship_status", "Invited"),
 				),
 			},
 			// Disassociate member
 			{
-				Config: testAccMemberConfig_invite(accountID, email, false),
+				Config: testAccMemberConfig_invite(false),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckMemberExists(ctx, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "invite", acctest.CtFalse),
@@ -99,28 +101,30 @@ func testAccMember_invite_disassociate(t *testing.T) {
 func testAccMember_invite_onUpdate(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_guardduty_member.test"
-	accountID, email := testAccMemberFromEnv(t)
+	memberDataSourceName := "data.aws_caller_identity.member"
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleAccount(t, 2)
 			testAccPreCheckDetectorNotExists(ctx, t)
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.GuardDutyServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesMultipleAccounts(ctx, t, 2),
 		CheckDestroy:             testAccCheckMemberDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccMemberConfig_invite(accountID, email, false),
+				Config: testAccMemberConfig_invite(false),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckMemberExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrAccountID, memberDataSourceName, names.AttrAccountID),
 					resource.TestCheckResourceAttr(resourceName, "invite", acctest.CtFalse),
 					resource.TestCheckResourceAttr(resourceName, "relationship_status", "Created"),
 				),
 			},
 			// Invite member
 			{
-				Config: testAccMemberConfig_invite(accountID, email, true),
+				Config: testAccMemberConfig_invite(true),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckMemberExists(ctx, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "invite", acctest.CtTrue),
@@ -142,26 +146,27 @@ func testAccMember_invite_onUpdate(t *testing.T) {
 func testAccMember_invitationMessage(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_guardduty_member.test"
-	accountID, email := testAccMemberFromEnv(t)
 	invitationMessage := "inviting"
+	memberDataSourceName := "data.aws_caller_identity.member"
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleAccount(t, 2)
 			testAccPreCheckDetectorNotExists(ctx, t)
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.GuardDutyServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesMultipleAccounts(ctx, t, 2),
 		CheckDestroy:             testAccCheckMemberDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccMemberConfig_invitationMessage(accountID, email, invitationMessage),
+				Config: testAccMemberConfig_invitationMessage(invitationMessage),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckMemberExists(ctx, resourceName),
-					resource.TestCheckResourceAttr(resourceName, names.AttrAccountID, accountID),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrAccountID, memberDataSourceName, names.AttrAccountID),
 					resource.TestCheckResourceAttrSet(resourceName, "detector_id"),
 					resource.TestCheckResourceAttr(resourceName, "disable_email_notification", acctest.CtTrue),
-					resource.TestCheckResourceAttr(resourceName, names.AttrEmail, email),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEmail, acctest.DefaultEmailAddress),
 					resource.TestCheckResourceAttr(resourceName, "invite", acctest.CtTrue),
 					resource.TestCheckResourceAttr(resourceName, "invitation_message", invitationMessage),
 					resource.TestCheckResourceAttr(resourceName, "relationship_status", "Invited"),
@@ -261,31 +266,35 @@ resource "aws_guardduty_member" "test" {
 `, testAccDetectorConfig_basic, accountID, email)
 }
 
-func testAccMemberConfig_invite(accountID, email string, invite bool) string {
-	return fmt.Sprintf(`
-%[1]s
+func testAccMemberConfig_invite(invite bool) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), testAccDetectorConfig_basic, fmt.Sprintf(`
+data "aws_caller_identity" "member" {
+  provider = "awsalternate"
+}
 
 resource "aws_guardduty_member" "test" {
-  account_id                 = "%[2]s"
+  account_id                 = data.aws_caller_identity.member.account_id
   detector_id                = aws_guardduty_detector.test.id
   disable_email_notification = true
-  email                      = "%[3]s"
-  invite                     = %[4]t
+  email                      = %[1]q
+  invite                     = %[2]t
 }
-`, testAccDetectorConfig_basic, accountID, email, invite)
+`, acctest.DefaultEmailAddress, invite))
 }
 
-func testAccMemberConfig_invitationMessage(accountID, email, invitationMessage string) string {
-	return fmt.Sprintf(`
-%[1]s
+func testAccMemberConfig_invitationMessage(invitationMessage string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), testAccDetectorConfig_basic, fmt.Sprintf(`
+data "aws_caller_identity" "member" {
+  provider = "awsalternate"
+}
 
 resource "aws_guardduty_member" "test" {
-  account_id                 = "%[2]s"
+  account_id                 = data.aws_caller_identity.member.account_id
   detector_id                = aws_guardduty_detector.test.id
   disable_email_notification = true
-  email                      = "%[3]s"
-  invitation_message         = "%[4]s"
+  email                      = %[1]q
+  invitation_message         = %[2]q
   invite                     = true
 }
-`, testAccDetectorConfig_basic, accountID, email, invitationMessage)
+`, acctest.DefaultEmailAddress, invitationMessage))
 }
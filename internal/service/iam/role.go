@@ -28,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -290,6 +291,11 @@ func resourceRoleRead(ctx context.Context, d *schema.ResourceData, meta interfac
 		return sdkdiag.AppendErrorf(diags, "reading IAM Role (%s): waiting for valid ARN: %s", d.Id(), err)
 	}
 
+	// Inline and managed policies are always read after create or import (when 'name' is not yet set).
+	// Otherwise they are read if configured or already present in state.
+	// Terraform does not send configuration when refreshing, in which case only state is used.
+	skipUnsetPolicies := meta.(*conns.AWSClient).SkipUnsetSubresourceReads(ctx) && !d.IsNewResource() && d.Get(names.AttrName).(string) != ""
+
 	d.Set(names.AttrARN, role.Arn)
 	d.Set("create_date", role.CreateDate.Format(time.RFC3339))
 	d.Set(names.AttrDescription, role.Description)
@@ -316,27 +322,31 @@ func resourceRoleRead(ctx context.Context, d *schema.ResourceData, meta interfac
 
 	d.Set("assume_role_policy", policyToSet)
 
-	inlinePolicies, err := readRoleInlinePolicies(ctx, conn, aws.ToString(role.RoleName))
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading inline policies for IAM role %s, error: %s", d.Id(), err)
-	}
+	if v := d.Get("inline_policy").(*schema.Set); !skipUnsetPolicies || v.Len() > 0 || sdkv2.HasNonNullRawValues(d.GetRawConfig(), "inline_policy") {
+		inlinePolicies, err := readRoleInlinePolicies(ctx, conn, aws.ToString(role.RoleName))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading inline policies for IAM role %s, error: %s", d.Id(), err)
+		}
 
-	var configPoliciesList []*iam.PutRolePolicyInput
-	if v := d.Get("inline_policy").(*schema.Set); v.Len() > 0 {
-		configPoliciesList = expandRoleInlinePolicies(aws.ToString(role.RoleName), v.List())
-	}
+		var configPoliciesList []*iam.PutRolePolicyInput
+		if v.Len() > 0 {
+			configPoliciesList = expandRoleInlinePolicies(aws.ToString(role.RoleName), v.List())
+		}
 
-	if !inlinePoliciesEquivalent(inlinePolicies, configPoliciesList) {
-		if err := d.Set("inline_policy", flattenRoleInlinePolicies(inlinePolicies)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting inline_policy: %s", err)
+		if !inlinePoliciesEquivalent(inlinePolicies, configPoliciesList) {
+			if err := d.Set("inline_policy", flattenRoleInlinePolicies(inlinePolicies)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting inline_policy: %s", err)
+			}
 		}
 	}
 
-	policyARNs, err := findRoleAttachedPolicies(ctx, conn, d.Id())
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading IAM Policies attached to Role (%s): %s", d.Id(), err)
+	if v := d.Get("managed_policy_arns").(*schema.Set); !skipUnsetPolicies || v.Len() > 0 || sdkv2.HasNonNullRawValues(d.GetRawConfig(), "managed_policy_arns") {
+		policyARNs, err := findRoleAttachedPolicies(ctx, conn, d.Id())
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading IAM Policies attached to Role (%s): %s", d.Id(), err)
+		}
+		d.Set("managed_policy_arns", policyARNs)
 	}
-	d.Set("managed_policy_arns", policyARNs)
 
 	setTagsOut(ctx, role.Tags)
 
@@ -47,6 +47,8 @@ func ResourceAssessmentTarget() *schema.Resource {
 				Optional: true,
 			},
 		},
+
+		DeprecationMessage: `Amazon Inspector Classic has reached end of support. Use the aws_inspector2_* resources instead.`,
 	}
 }
 
@@ -91,6 +91,8 @@ func ResourceAssessmentTemplate() *schema.Resource {
 		},
 
 		CustomizeDiff: verify.SetTagsDiff,
+
+		DeprecationMessage: `Amazon Inspector Classic has reached end of support. Use the aws_inspector2_* resources instead.`,
 	}
 }
 
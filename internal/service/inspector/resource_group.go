@@ -36,6 +36,8 @@ func ResourceResourceGroup() *schema.Resource {
 				Computed: true,
 			},
 		},
+
+		DeprecationMessage: `Amazon Inspector Classic has reached end of support. Use the aws_inspector2_* resources instead.`,
 	}
 }
 
@@ -27,6 +27,8 @@ func DataSourceRulesPackages() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 		},
+
+		DeprecationMessage: `Amazon Inspector Classic has reached end of support. Use the aws_inspector2_* resources instead.`,
 	}
 }
 
@@ -6,6 +6,8 @@ package kms
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"log"
 	"slices"
@@ -38,6 +40,7 @@ func resourceGrant() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGrantCreate,
 		ReadWithoutTimeout:   resourceGrantRead,
+		UpdateWithoutTimeout: resourceGrantUpdate,
 		DeleteWithoutTimeout: resourceGrantDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -112,6 +115,7 @@ func resourceGrant() *schema.Resource {
 			names.AttrName: {
 				Type:         schema.TypeString,
 				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: validGrantName,
 			},
@@ -128,18 +132,18 @@ func resourceGrant() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
-				ForceNew: true,
 			},
 			"retiring_principal": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 				ValidateFunc: validation.Any(
 					verify.ValidARN,
 					verify.ValidServicePrincipal,
 				),
 			},
 		},
+
+		CustomizeDiff: resourceGrantCustomizeDiff,
 	}
 }
 
@@ -148,44 +152,18 @@ func resourceGrantCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	conn := meta.(*conns.AWSClient).KMSClient(ctx)
 
 	keyID := d.Get(names.AttrKeyID).(string)
-	input := &kms.CreateGrantInput{
-		GranteePrincipal: aws.String(d.Get("grantee_principal").(string)),
-		KeyId:            aws.String(keyID),
-		Operations:       flex.ExpandStringyValueSet[awstypes.GrantOperation](d.Get("operations").(*schema.Set)),
-	}
-
-	if v, ok := d.GetOk("constraints"); ok && v.(*schema.Set).Len() > 0 {
-		if !grantConstraintsIsValid(v.(*schema.Set)) {
-			return sdkdiag.AppendErrorf(diags, "A grant constraint can't have both encryption_context_equals and encryption_context_subset set")
-		}
-
-		input.Constraints = expandGrantConstraints(v.(*schema.Set))
-	}
-
-	if v, ok := d.GetOk("grant_creation_tokens"); ok && v.(*schema.Set).Len() > 0 {
-		input.GrantTokens = flex.ExpandStringValueSet(v.(*schema.Set))
-	}
+	input, err := expandCreateGrantInput(d)
 
-	if v, ok := d.GetOk(names.AttrName); ok {
-		input.Name = aws.String(v.(string))
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
 	}
 
-	if v, ok := d.GetOk("retiring_principal"); ok {
-		input.RetiringPrincipal = aws.String(v.(string))
-	}
-
-	// Error Codes: https://docs.aws.amazon.com/sdk-for-go/api/service/kms/#KMS.CreateGrant
-	// Under some circumstances a newly created IAM Role doesn't show up and causes
-	// an InvalidArnException to be thrown.
-	outputRaw, err := tfresource.RetryWhenIsOneOf3[*awstypes.DependencyTimeoutException, *awstypes.KMSInternalException, *awstypes.InvalidArnException](ctx, propagationTimeout, func() (interface{}, error) {
-		return conn.CreateGrant(ctx, input)
-	})
+	output, err := createGrant(ctx, conn, input)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating KMS Grant for Key (%s): %s", keyID, err)
 	}
 
-	output := outputRaw.(*kms.CreateGrantOutput)
 	grantID := aws.ToString(output.GrantId)
 	d.SetId(grantCreateResourceID(keyID, grantID))
 	d.Set("grant_token", output.GrantToken)
@@ -235,6 +213,43 @@ func resourceGrantRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	return diags
 }
 
+func resourceGrantUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KMSClient(ctx)
+
+	// KMS grants are immutable. A change of retiring principal is applied by
+	// creating a grant with the new retiring principal and then retiring or
+	// revoking the old one, so that the grantee never loses access.
+	if d.HasChange("retiring_principal") {
+		keyID, oldGrantID, err := grantParseResourceID(d.Id())
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input, err := expandCreateGrantInput(d)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		output, err := createGrant(ctx, conn, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating KMS Grant (%s): creating replacement grant: %s", d.Id(), err)
+		}
+
+		oldID := d.Id()
+		d.SetId(grantCreateResourceID(keyID, aws.ToString(output.GrantId)))
+		d.Set("grant_token", output.GrantToken)
+
+		if err := deleteGrant(ctx, conn, keyID, oldGrantID, d.Get("retire_on_delete").(bool)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating KMS Grant (%s): deleting previous grant (%s): %s", d.Id(), oldID, err)
+		}
+	}
+
+	return append(diags, resourceGrantRead(ctx, d, meta)...)
+}
+
 func resourceGrantDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).KMSClient(ctx)
@@ -244,14 +259,89 @@ func resourceGrantDelete(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
-	if d.Get("retire_on_delete").(bool) {
-		log.Printf("[DEBUG] Retiring KMS Grant: %s", d.Id())
+	if err := deleteGrant(ctx, conn, keyID, grantID, d.Get("retire_on_delete").(bool)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting KMS Grant (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceGrantCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() != "" && d.HasChange("retiring_principal") {
+		if err := d.SetNewComputed("grant_id"); err != nil {
+			return err
+		}
+
+		if err := d.SetNewComputed("grant_token"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func expandCreateGrantInput(d *schema.ResourceData) (*kms.CreateGrantInput, error) {
+	input := &kms.CreateGrantInput{
+		GranteePrincipal: aws.String(d.Get("grantee_principal").(string)),
+		KeyId:            aws.String(d.Get(names.AttrKeyID).(string)),
+		Operations:       flex.ExpandStringyValueSet[awstypes.GrantOperation](d.Get("operations").(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk("constraints"); ok && v.(*schema.Set).Len() > 0 {
+		if !grantConstraintsIsValid(v.(*schema.Set)) {
+			return nil, fmt.Errorf("A grant constraint can't have both encryption_context_equals and encryption_context_subset set")
+		}
+
+		input.Constraints = expandGrantConstraints(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("grant_creation_tokens"); ok && v.(*schema.Set).Len() > 0 {
+		input.GrantTokens = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("retiring_principal"); ok {
+		input.RetiringPrincipal = aws.String(v.(string))
+	}
+
+	// The grant name is KMS's idempotency token for CreateGrant: a repeated
+	// request with the same name and parameters returns the existing grant
+	// rather than creating a duplicate. When no name is configured derive one
+	// from the grant's parameters so that retried applies are idempotent.
+	if v, ok := d.GetOk(names.AttrName); ok {
+		input.Name = aws.String(v.(string))
+	} else if d.Id() == "" {
+		input.Name = aws.String(grantIdempotencyName(input))
+	}
+
+	return input, nil
+}
+
+func createGrant(ctx context.Context, conn *kms.Client, input *kms.CreateGrantInput) (*kms.CreateGrantOutput, error) {
+	// Error Codes: https://docs.aws.amazon.com/sdk-for-go/api/service/kms/#KMS.CreateGrant
+	// Under some circumstances a newly created IAM Role doesn't show up and causes
+	// an InvalidArnException to be thrown.
+	outputRaw, err := tfresource.RetryWhenIsOneOf3[*awstypes.DependencyTimeoutException, *awstypes.KMSInternalException, *awstypes.InvalidArnException](ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateGrant(ctx, input)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return outputRaw.(*kms.CreateGrantOutput), nil
+}
+
+func deleteGrant(ctx context.Context, conn *kms.Client, keyID, grantID string, retire bool) error {
+	var err error
+
+	if retire {
+		log.Printf("[DEBUG] Retiring KMS Grant: %s", grantCreateResourceID(keyID, grantID))
 		_, err = conn.RetireGrant(ctx, &kms.RetireGrantInput{
 			GrantId: aws.String(grantID),
 			KeyId:   aws.String(keyID),
 		})
 	} else {
-		log.Printf("[DEBUG] Revoking KMS Grant: %s", d.Id())
+		log.Printf("[DEBUG] Revoking KMS Grant: %s", grantCreateResourceID(keyID, grantID))
 		_, err = conn.RevokeGrant(ctx, &kms.RevokeGrantInput{
 			GrantId: aws.String(grantID),
 			KeyId:   aws.String(keyID),
@@ -259,11 +349,11 @@ func resourceGrantDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	if errs.IsA[*awstypes.NotFoundException](err) {
-		return diags
+		return nil
 	}
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "deleting KMS Grant (%s): %s", d.Id(), err)
+		return err
 	}
 
 	_, err = tfresource.RetryUntilNotFound(ctx, propagationTimeout, func() (interface{}, error) {
@@ -271,10 +361,10 @@ func resourceGrantDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	})
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for KMS Grant (%s) delete: %s", d.Id(), err)
+		return fmt.Errorf("waiting for delete: %w", err)
 	}
 
-	return diags
+	return nil
 }
 
 func findGrant(ctx context.Context, conn *kms.Client, input *kms.ListGrantsInput, filter tfslices.Predicate[*awstypes.GrantListEntry]) (*awstypes.GrantListEntry, error) {
@@ -474,6 +564,28 @@ func resourceGrantConstraintsHash(v interface{}) int {
 	return create.StringHashcode(buf.String())
 }
 
+// grantIdempotencyName returns a grant name derived from the key, grantee
+// principal, operations and constraints of the grant being created.
+func grantIdempotencyName(input *kms.CreateGrantInput) string {
+	operations := tfslices.ApplyToAll(input.Operations, func(v awstypes.GrantOperation) string {
+		return string(v)
+	})
+	slices.Sort(operations)
+
+	var buf bytes.Buffer
+	buf.WriteString(aws.ToString(input.KeyId) + "\n")
+	buf.WriteString(aws.ToString(input.GranteePrincipal) + "\n")
+	buf.WriteString(strings.Join(operations, ",") + "\n")
+	if v := input.Constraints; v != nil {
+		buf.WriteString(fmt.Sprintf("encryption_context_equals-%s\n", sortedConcatStringMap(v.EncryptionContextEquals)))
+		buf.WriteString(fmt.Sprintf("encryption_context_subset-%s\n", sortedConcatStringMap(v.EncryptionContextSubset)))
+	}
+
+	hash := sha256.Sum256(buf.Bytes())
+
+	return "terraform-" + hex.EncodeToString(hash[:16])
+}
+
 const grantResourceIDSeparator = ":"
 
 func grantCreateResourceID(keyID, grantID string) string {
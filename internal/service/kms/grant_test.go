@@ -8,8 +8,10 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -128,6 +130,41 @@ func TestAccKMSGrant_withRetiringPrincipal(t *testing.T) {
 	})
 }
 
+func TestAccKMSGrant_updateRetiringPrincipal(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_kms_grant.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGrantDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGrantConfig_retiringPrincipal(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGrantExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "retiring_principal", "aws_iam_role.test", names.AttrARN),
+				),
+			},
+			{
+				Config: testAccGrantConfig_retiringPrincipalUpdated(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGrantExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrPair(resourceName, "retiring_principal", "aws_iam_role.test2", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKMSGrant_bare(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_kms_grant.test"
@@ -143,7 +180,7 @@ func TestAccKMSGrant_bare(t *testing.T) {
 				Config: testAccGrantConfig_bare(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckGrantExists(ctx, resourceName),
-					resource.TestCheckNoResourceAttr(resourceName, names.AttrName),
+					resource.TestMatchResourceAttr(resourceName, names.AttrName, regexache.MustCompile(`^terraform-[0-9a-f]{32}$`)),
 					resource.TestCheckNoResourceAttr(resourceName, "constraints.#"),
 					resource.TestCheckNoResourceAttr(resourceName, "retiring_principal"),
 				),
@@ -430,6 +467,24 @@ resource "aws_kms_grant" "test" {
 `, rName))
 }
 
+func testAccGrantConfig_retiringPrincipalUpdated(rName string) string {
+	return acctest.ConfigCompose(testAccGrantConfig_base(rName), fmt.Sprintf(`
+resource "aws_iam_role" "test2" {
+  name               = "%[1]s-2"
+  path               = "/service-role/"
+  assume_role_policy = data.aws_iam_policy_document.test.json
+}
+
+resource "aws_kms_grant" "test" {
+  name               = %[1]q
+  key_id             = aws_kms_key.test.key_id
+  grantee_principal  = aws_iam_role.test.arn
+  operations         = ["ReEncryptTo", "CreateGrant"]
+  retiring_principal = aws_iam_role.test2.arn
+}
+`, rName))
+}
+
 func testAccGrantConfig_bare(rName string) string {
 	return acctest.ConfigCompose(testAccGrantConfig_base(rName), `
 resource "aws_kms_grant" "test" {
@@ -211,6 +211,12 @@ func resourceKeyCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 	}
 
 	// Wait for propagation since KMS is eventually consistent.
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		if err := waitKeyDescriptionPropagated(ctx, conn, d.Id(), v.(string)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for KMS Key (%s) description update: %s", d.Id(), err)
+		}
+	}
+
 	if v, ok := d.GetOk(names.AttrPolicy); ok {
 		if err := waitKeyPolicyPropagated(ctx, conn, d.Id(), v.(string)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for KMS Key (%s) policy update: %s", d.Id(), err)
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -458,6 +459,7 @@ func resourceFunction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			checkDeadLetterConfigTarget,
 			updateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
 		),
@@ -1333,6 +1335,33 @@ func checkHandlerRuntimeForZipFunction(_ context.Context, d *schema.ResourceDiff
 	return nil
 }
 
+// checkDeadLetterConfigTarget validates that the dead-letter queue is a standard SQS queue or SNS topic.
+func checkDeadLetterConfigTarget(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("dead_letter_config") {
+		return nil
+	}
+
+	v, ok := d.GetOk("dead_letter_config.0.target_arn")
+	if !ok {
+		return nil
+	}
+
+	targetARN, err := arn.Parse(v.(string))
+	if err != nil {
+		return nil
+	}
+
+	if targetARN.Service != names.SQS && targetARN.Service != names.SNS {
+		return fmt.Errorf("dead_letter_config target_arn (%s) must be an SQS queue or SNS topic", v.(string))
+	}
+
+	if strings.HasSuffix(targetARN.Resource, ".fifo") {
+		return fmt.Errorf("dead_letter_config target_arn (%s) must be a standard SQS queue or SNS topic, FIFO is not supported", v.(string))
+	}
+
+	return nil
+}
+
 func updateComputedAttributesOnPublish(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	configChanged := needsFunctionConfigUpdate(d)
 	codeChanged := needsFunctionCodeUpdate(d)
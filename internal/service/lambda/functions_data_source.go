@@ -8,9 +8,11 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 )
 
@@ -30,6 +32,11 @@ func dataSourceFunctions() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"runtime": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.Runtime](),
+			},
 		},
 	}
 }
@@ -41,6 +48,11 @@ func dataSourceFunctionsRead(ctx context.Context, d *schema.ResourceData, meta i
 	var functionARNs []string
 	var functionNames []string
 
+	var runtime awstypes.Runtime
+	if v, ok := d.GetOk("runtime"); ok {
+		runtime = awstypes.Runtime(v.(string))
+	}
+
 	input := &lambda.ListFunctionsInput{}
 	pages := lambda.NewListFunctionsPaginator(conn, input)
 	for pages.HasMorePages() {
@@ -51,6 +63,10 @@ func dataSourceFunctionsRead(ctx context.Context, d *schema.ResourceData, meta i
 		}
 
 		for _, v := range page.Functions {
+			if runtime != "" && v.Runtime != runtime {
+				continue
+			}
+
 			functionARNs = append(functionARNs, aws.ToString(v.FunctionArn))
 			functionNames = append(functionNames, aws.ToString(v.FunctionName))
 		}
@@ -33,6 +33,30 @@ func TestAccLambdaFunctionsDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunctionsDataSource_runtime(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lambda_functions.test"
+	resourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionsDataSourceConfig_runtime(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "function_arns.#", 0),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "function_arns.*", resourceName, names.AttrARN),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "function_names.*", resourceName, "function_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "runtime", "nodejs16.x"),
+				),
+			},
+		},
+	})
+}
+
 func testAccFunctionsDataSourceConfig_basic(rName string) string {
 	return acctest.ConfigCompose(testAccFunctionConfig_basic(rName, rName, rName, rName), `
 data "aws_lambda_functions" "test" {
@@ -40,3 +64,13 @@ data "aws_lambda_functions" "test" {
 }
 `)
 }
+
+func testAccFunctionsDataSourceConfig_runtime(rName string) string {
+	return acctest.ConfigCompose(testAccFunctionConfig_basic(rName, rName, rName, rName), `
+data "aws_lambda_functions" "test" {
+  runtime = aws_lambda_function.test.runtime
+
+  depends_on = [aws_lambda_function.test]
+}
+`)
+}
@@ -9,9 +9,11 @@ var (
 	ResourceGrant                = resourceGrant
 	ResourceGrantAccepter        = resourceGrantAccepter
 	ResourceLicenseConfiguration = resourceLicenseConfiguration
+	ResourceReportGenerator      = resourceReportGenerator
 
 	FindAssociationByTwoPartKey   = findAssociationByTwoPartKey
 	FindGrantByARN                = findGrantByARN
 	FindReceivedGrantByARN        = findReceivedGrantByARN
 	FindLicenseConfigurationByARN = findLicenseConfigurationByARN
+	FindReportGeneratorByARN      = findReportGeneratorByARN
 )
@@ -48,6 +48,11 @@ func resourceLicenseConfiguration() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"disassociate_when_not_found": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"license_count": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -66,7 +71,6 @@ func resourceLicenseConfiguration() *schema.Resource {
 			"license_rules": {
 				Type:     schema.TypeList,
 				Optional: true,
-				ForceNew: true,
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
 					ValidateFunc: validation.StringMatch(regexache.MustCompile("^#([^=]+)=(.+)$"), "Expected format is #RuleType=RuleValue"),
@@ -103,6 +107,10 @@ func resourceLicenseConfigurationCreate(ctx context.Context, d *schema.ResourceD
 		input.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("disassociate_when_not_found"); ok {
+		input.DisassociateWhenNotFound = aws.Bool(v.(bool))
+	}
+
 	if v, ok := d.GetOk("license_count"); ok {
 		input.LicenseCount = aws.Int64(int64(v.(int)))
 	}
@@ -144,6 +152,7 @@ func resourceLicenseConfigurationRead(ctx context.Context, d *schema.ResourceDat
 
 	d.Set(names.AttrARN, output.LicenseConfigurationArn)
 	d.Set(names.AttrDescription, output.Description)
+	d.Set("disassociate_when_not_found", output.DisassociateWhenNotFound)
 	d.Set("license_count", output.LicenseCount)
 	d.Set("license_count_hard_limit", output.LicenseCountHardLimit)
 	d.Set("license_counting_type", output.LicenseCountingType)
@@ -162,16 +171,21 @@ func resourceLicenseConfigurationUpdate(ctx context.Context, d *schema.ResourceD
 
 	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
 		input := &licensemanager.UpdateLicenseConfigurationInput{
-			Description:             aws.String(d.Get(names.AttrDescription).(string)),
-			LicenseConfigurationArn: aws.String(d.Id()),
-			LicenseCountHardLimit:   aws.Bool(d.Get("license_count_hard_limit").(bool)),
-			Name:                    aws.String(d.Get(names.AttrName).(string)),
+			Description:              aws.String(d.Get(names.AttrDescription).(string)),
+			DisassociateWhenNotFound: aws.Bool(d.Get("disassociate_when_not_found").(bool)),
+			LicenseConfigurationArn:  aws.String(d.Id()),
+			LicenseCountHardLimit:    aws.Bool(d.Get("license_count_hard_limit").(bool)),
+			Name:                     aws.String(d.Get(names.AttrName).(string)),
 		}
 
 		if v, ok := d.GetOk("license_count"); ok {
 			input.LicenseCount = aws.Int64(int64(v.(int)))
 		}
 
+		if d.HasChange("license_rules") {
+			input.LicenseRules = flex.ExpandStringValueList(d.Get("license_rules").([]interface{}))
+		}
+
 		_, err := conn.UpdateLicenseConfiguration(ctx, input)
 
 		if err != nil {
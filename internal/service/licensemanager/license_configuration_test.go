@@ -48,6 +48,7 @@ func TestAccLicenseManagerLicenseConfiguration_basic(t *testing.T) {
 					testAccCheckLicenseConfigurationExists(ctx, resourceName, &licenseConfiguration),
 					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "license-manager", regexache.MustCompile(`license-configuration:lic-.+`)),
 					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+					resource.TestCheckResourceAttr(resourceName, "disassociate_when_not_found", acctest.CtFalse),
 					resource.TestCheckResourceAttr(resourceName, "license_count", acctest.Ct0),
 					resource.TestCheckResourceAttr(resourceName, "license_count_hard_limit", acctest.CtFalse),
 					resource.TestCheckResourceAttr(resourceName, "license_counting_type", "Instance"),
@@ -155,6 +156,7 @@ func TestAccLicenseManagerLicenseConfiguration_update(t *testing.T) {
 					testAccCheckLicenseConfigurationExists(ctx, resourceName, &licenseConfiguration),
 					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "license-manager", regexache.MustCompile(`license-configuration:lic-.+`)),
 					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "test1"),
+					resource.TestCheckResourceAttr(resourceName, "disassociate_when_not_found", acctest.CtTrue),
 					resource.TestCheckResourceAttr(resourceName, "license_count", acctest.Ct10),
 					resource.TestCheckResourceAttr(resourceName, "license_count_hard_limit", acctest.CtTrue),
 					resource.TestCheckResourceAttr(resourceName, "license_counting_type", "Socket"),
@@ -176,11 +178,12 @@ func TestAccLicenseManagerLicenseConfiguration_update(t *testing.T) {
 					testAccCheckLicenseConfigurationExists(ctx, resourceName, &licenseConfiguration),
 					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "license-manager", regexache.MustCompile(`license-configuration:lic-.+`)),
 					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "test2"),
+					resource.TestCheckResourceAttr(resourceName, "disassociate_when_not_found", acctest.CtFalse),
 					resource.TestCheckResourceAttr(resourceName, "license_count", "99"),
 					resource.TestCheckResourceAttr(resourceName, "license_count_hard_limit", acctest.CtFalse),
 					resource.TestCheckResourceAttr(resourceName, "license_counting_type", "Socket"),
 					resource.TestCheckResourceAttr(resourceName, "license_rules.#", acctest.Ct1),
-					resource.TestCheckResourceAttr(resourceName, "license_rules.0", "#minimumSockets=3"),
+					resource.TestCheckResourceAttr(resourceName, "license_rules.0", "#minimumSockets=4"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName2),
 					acctest.CheckResourceAttrAccountID(resourceName, names.AttrOwnerAccountID),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
@@ -276,11 +279,12 @@ resource "aws_licensemanager_license_configuration" "test" {
 func testAccLicenseConfigurationConfig_allAttributes(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_licensemanager_license_configuration" "test" {
-  name                     = %[1]q
-  description              = "test1"
-  license_count            = 10
-  license_count_hard_limit = true
-  license_counting_type    = "Socket"
+  name                        = %[1]q
+  description                 = "test1"
+  disassociate_when_not_found = true
+  license_count               = 10
+  license_count_hard_limit    = true
+  license_counting_type       = "Socket"
 
   license_rules = [
     "#minimumSockets=3"
@@ -298,7 +302,7 @@ resource "aws_licensemanager_license_configuration" "test" {
   license_counting_type = "Socket"
 
   license_rules = [
-    "#minimumSockets=3"
+    "#minimumSockets=4"
   ]
 }
 `, rName)
@@ -0,0 +1,304 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package licensemanager
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/licensemanager"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/licensemanager/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_licensemanager_report_generator", name="Report Generator")
+// @Tags(identifierAttribute="id")
+func resourceReportGenerator() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceReportGeneratorCreate,
+		ReadWithoutTimeout:   resourceReportGeneratorRead,
+		UpdateWithoutTimeout: resourceReportGeneratorUpdate,
+		DeleteWithoutTimeout: resourceReportGeneratorDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"license_configuration_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 100),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only alphanumeric characters, underscores and hyphens"),
+				),
+			},
+			"report_frequency": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"period": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.ReportFrequencyType](),
+						},
+						names.AttrValue: {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"report_type": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[awstypes.ReportType](),
+				},
+			},
+			"s3_location": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrBucket: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key_prefix": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceReportGeneratorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LicenseManagerClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &licensemanager.CreateLicenseManagerReportGeneratorInput{
+		ClientToken: aws.String(id.UniqueId()),
+		ReportContext: &awstypes.ReportContext{
+			LicenseConfigurationArns: flex.ExpandStringValueSet(d.Get("license_configuration_arns").(*schema.Set)),
+		},
+		ReportFrequency:     expandReportFrequency(d.Get("report_frequency").([]interface{})),
+		ReportGeneratorName: aws.String(name),
+		Tags:                getTagsIn(ctx),
+		Type:                flex.ExpandStringyValueSet[awstypes.ReportType](d.Get("report_type").(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateLicenseManagerReportGenerator(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating License Manager Report Generator (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.LicenseManagerReportGeneratorArn))
+
+	return append(diags, resourceReportGeneratorRead(ctx, d, meta)...)
+}
+
+func resourceReportGeneratorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LicenseManagerClient(ctx)
+
+	reportGenerator, err := findReportGeneratorByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] License Manager Report Generator %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading License Manager Report Generator (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, reportGenerator.LicenseManagerReportGeneratorArn)
+	d.Set(names.AttrDescription, reportGenerator.Description)
+	if reportGenerator.ReportContext != nil {
+		d.Set("license_configuration_arns", reportGenerator.ReportContext.LicenseConfigurationArns)
+	} else {
+		d.Set("license_configuration_arns", nil)
+	}
+	d.Set(names.AttrName, reportGenerator.ReportGeneratorName)
+	if err := d.Set("report_frequency", flattenReportFrequency(reportGenerator.ReportFrequency)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting report_frequency: %s", err)
+	}
+	d.Set("report_type", reportGenerator.ReportType)
+	if err := d.Set("s3_location", flattenS3Location(reportGenerator.S3Location)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting s3_location: %s", err)
+	}
+
+	setTagsOut(ctx, reportGenerator.Tags)
+
+	return diags
+}
+
+func resourceReportGeneratorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LicenseManagerClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &licensemanager.UpdateLicenseManagerReportGeneratorInput{
+			ClientToken:                      aws.String(id.UniqueId()),
+			Description:                      aws.String(d.Get(names.AttrDescription).(string)),
+			LicenseManagerReportGeneratorArn: aws.String(d.Id()),
+			ReportContext: &awstypes.ReportContext{
+				LicenseConfigurationArns: flex.ExpandStringValueSet(d.Get("license_configuration_arns").(*schema.Set)),
+			},
+			ReportFrequency:     expandReportFrequency(d.Get("report_frequency").([]interface{})),
+			ReportGeneratorName: aws.String(d.Get(names.AttrName).(string)),
+			Type:                flex.ExpandStringyValueSet[awstypes.ReportType](d.Get("report_type").(*schema.Set)),
+		}
+
+		_, err := conn.UpdateLicenseManagerReportGenerator(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating License Manager Report Generator (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceReportGeneratorRead(ctx, d, meta)...)
+}
+
+func resourceReportGeneratorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LicenseManagerClient(ctx)
+
+	log.Printf("[DEBUG] Deleting License Manager Report Generator: %s", d.Id())
+	_, err := conn.DeleteLicenseManagerReportGenerator(ctx, &licensemanager.DeleteLicenseManagerReportGeneratorInput{
+		LicenseManagerReportGeneratorArn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting License Manager Report Generator (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findReportGeneratorByARN(ctx context.Context, conn *licensemanager.Client, arn string) (*awstypes.ReportGenerator, error) {
+	input := &licensemanager.GetLicenseManagerReportGeneratorInput{
+		LicenseManagerReportGeneratorArn: aws.String(arn),
+	}
+
+	output, err := conn.GetLicenseManagerReportGenerator(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ReportGenerator == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ReportGenerator, nil
+}
+
+func expandReportFrequency(tfList []interface{}) *awstypes.ReportFrequency {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &awstypes.ReportFrequency{}
+
+	if v, ok := tfMap["period"].(string); ok && v != "" {
+		apiObject.Period = awstypes.ReportFrequencyType(v)
+	}
+
+	if v, ok := tfMap[names.AttrValue].(int); ok && v != 0 {
+		apiObject.Value = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func flattenReportFrequency(apiObject *awstypes.ReportFrequency) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"period":        apiObject.Period,
+		names.AttrValue: aws.ToInt32(apiObject.Value),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenS3Location(apiObject *awstypes.S3Location) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrBucket: aws.ToString(apiObject.Bucket),
+		"key_prefix":     aws.ToString(apiObject.KeyPrefix),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package licensemanager_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/licensemanager/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflicensemanager "github.com/hashicorp/terraform-provider-aws/internal/service/licensemanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLicenseManagerReportGenerator_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var reportGenerator awstypes.ReportGenerator
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_licensemanager_report_generator.test"
+	licenseConfigurationResourceName := "aws_licensemanager_license_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LicenseManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReportGeneratorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReportGeneratorConfig_basic(rName, "DAY"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReportGeneratorExists(ctx, resourceName, &reportGenerator),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "license-manager", regexache.MustCompile(`report-generator:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "license_configuration_arns.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "license_configuration_arns.*", licenseConfigurationResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "report_frequency.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "report_frequency.0.period", "DAY"),
+					resource.TestCheckResourceAttr(resourceName, "report_type.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "report_type.*", "LicenseConfigurationSummaryReport"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccReportGeneratorConfig_basic(rName, "WEEK"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReportGeneratorExists(ctx, resourceName, &reportGenerator),
+					resource.TestCheckResourceAttr(resourceName, "report_frequency.0.period", "WEEK"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLicenseManagerReportGenerator_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var reportGenerator awstypes.ReportGenerator
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_licensemanager_report_generator.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LicenseManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReportGeneratorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReportGeneratorConfig_basic(rName, "DAY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReportGeneratorExists(ctx, resourceName, &reportGenerator),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflicensemanager.ResourceReportGenerator(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckReportGeneratorExists(ctx context.Context, n string, v *awstypes.ReportGenerator) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LicenseManagerClient(ctx)
+
+		output, err := tflicensemanager.FindReportGeneratorByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckReportGeneratorDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LicenseManagerClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_licensemanager_report_generator" {
+				continue
+			}
+
+			_, err := tflicensemanager.FindReportGeneratorByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("License Manager Report Generator %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccReportGeneratorConfig_basic(rName, period string) string {
+	return fmt.Sprintf(`
+resource "aws_licensemanager_license_configuration" "test" {
+  name                  = %[1]q
+  license_counting_type = "Instance"
+}
+
+resource "aws_licensemanager_report_generator" "test" {
+  name                       = %[1]q
+  license_configuration_arns = [aws_licensemanager_license_configuration.test.arn]
+  report_type                = ["LicenseConfigurationSummaryReport"]
+
+  report_frequency {
+    period = %[2]q
+    value  = 1
+  }
+}
+`, rName, period)
+}
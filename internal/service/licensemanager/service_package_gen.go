@@ -67,6 +67,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  resourceReportGenerator,
+			TypeName: "aws_licensemanager_report_generator",
+			Name:     "Report Generator",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrID,
+			},
+		},
 	}
 }
 
@@ -5,23 +5,25 @@ package logs
 
 // Exports for use in tests only.
 var (
-	ResourceAccountPolicy        = resourceAccountPolicy
-	ResourceDataProtectionPolicy = resourceDataProtectionPolicy
-	ResourceDestination          = resourceDestination
-	ResourceDestinationPolicy    = resourceDestinationPolicy
-	ResourceGroup                = resourceGroup
-	ResourceMetricFilter         = resourceMetricFilter
-	ResourceQueryDefinition      = resourceQueryDefinition
-	ResourceResourcePolicy       = resourceResourcePolicy
-	ResourceStream               = resourceStream
-	ResourceSubscriptionFilter   = resourceSubscriptionFilter
+	ResourceAccountPolicy           = resourceAccountPolicy
+	ResourceDataProtectionPolicy    = resourceDataProtectionPolicy
+	ResourceDestination             = resourceDestination
+	ResourceDestinationPolicy       = resourceDestinationPolicy
+	ResourceGroup                   = resourceGroup
+	ResourceMetricFilter            = resourceMetricFilter
+	ResourceQueryDefinition         = resourceQueryDefinition
+	ResourceResourcePolicy          = resourceResourcePolicy
+	ResourceResourcePolicyStatement = resourceResourcePolicyStatement
+	ResourceStream                  = resourceStream
+	ResourceSubscriptionFilter      = resourceSubscriptionFilter
 
-	FindAccountPolicyByTwoPartKey      = findAccountPolicyByTwoPartKey
-	FindDestinationByName              = findDestinationByName
-	FindLogGroupByName                 = findLogGroupByName
-	FindLogStreamByTwoPartKey          = findLogStreamByTwoPartKey // nosemgrep:ci.logs-in-var-name
-	FindMetricFilterByTwoPartKey       = findMetricFilterByTwoPartKey
-	FindQueryDefinitionByTwoPartKey    = findQueryDefinitionByTwoPartKey
-	FindResourcePolicyByName           = findResourcePolicyByName
-	FindSubscriptionFilterByTwoPartKey = findSubscriptionFilterByTwoPartKey
+	FindAccountPolicyByTwoPartKey           = findAccountPolicyByTwoPartKey
+	FindDestinationByName                   = findDestinationByName
+	FindLogGroupByName                      = findLogGroupByName
+	FindLogStreamByTwoPartKey               = findLogStreamByTwoPartKey // nosemgrep:ci.logs-in-var-name
+	FindMetricFilterByTwoPartKey            = findMetricFilterByTwoPartKey
+	FindQueryDefinitionByTwoPartKey         = findQueryDefinitionByTwoPartKey
+	FindResourcePolicyByName                = findResourcePolicyByName
+	FindResourcePolicyStatementByTwoPartKey = findResourcePolicyStatementByTwoPartKey
+	FindSubscriptionFilterByTwoPartKey      = findSubscriptionFilterByTwoPartKey
 )
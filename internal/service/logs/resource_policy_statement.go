@@ -0,0 +1,368 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	resourcePolicyDocumentMaxLength            = 5120
+	resourcePolicyStatementResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_cloudwatch_log_resource_policy_statement", name="Resource Policy Statement")
+func resourceResourcePolicyStatement() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceResourcePolicyStatementPut,
+		ReadWithoutTimeout:   resourceResourcePolicyStatementRead,
+		UpdateWithoutTimeout: resourceResourcePolicyStatementPut,
+		DeleteWithoutTimeout: resourceResourcePolicyStatementDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				parts, err := flex.ExpandResourceId(d.Id(), resourcePolicyStatementResourceIDPartCount, false)
+
+				if err != nil {
+					return nil, err
+				}
+
+				d.Set("policy_name", parts[0])
+				d.Set("statement_id", parts[1])
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"statement": {
+				Type:                  schema.TypeString,
+				Required:              true,
+				ValidateFunc:          validResourcePolicyStatement,
+				DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+				DiffSuppressOnRefresh: true,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"statement_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 100),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z]+$`), "must contain only alphanumeric characters"),
+				),
+			},
+		},
+	}
+}
+
+func resourceResourcePolicyStatementPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LogsClient(ctx)
+
+	var statement map[string]interface{}
+	if err := tfjson.DecodeFromString(d.Get("statement").(string), &statement); err != nil {
+		return sdkdiag.AppendErrorf(diags, "statement is invalid JSON: %s", err)
+	}
+
+	policyName, sid := d.Get("policy_name").(string), d.Get("statement_id").(string)
+	id, err := flex.FlattenResourceId([]string{policyName, sid}, resourcePolicyStatementResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	statement["Sid"] = sid
+
+	mutexKey := resourcePolicyStatementMutexKey(policyName)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	policy, err := findResourcePolicyDocument(ctx, conn, policyName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudWatch Logs Resource Policy (%s): %s", policyName, err)
+	}
+
+	// Each statement is owned by a single resource.
+	if d.IsNewResource() && policy.hasStatement(sid) {
+		return sdkdiag.AppendErrorf(diags, "creating CloudWatch Logs Resource Policy Statement (%s): statement already exists in CloudWatch Logs Resource Policy (%s)", id, policyName)
+	}
+
+	policy.upsertStatement(statement)
+
+	if err := putResourcePolicyDocument(ctx, conn, policyName, policy); err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting CloudWatch Logs Resource Policy Statement (%s): %s", id, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(id)
+	}
+
+	return append(diags, resourceResourcePolicyStatementRead(ctx, d, meta)...)
+}
+
+func resourceResourcePolicyStatementRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LogsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), resourcePolicyStatementResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	policyName, sid := parts[0], parts[1]
+	statement, err := findResourcePolicyStatementByTwoPartKey(ctx, conn, policyName, sid)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Logs Resource Policy Statement (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudWatch Logs Resource Policy Statement (%s): %s", d.Id(), err)
+	}
+
+	delete(statement, "Sid")
+
+	statementJSON, err := tfjson.EncodeToString(statement)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	statementToSet, err := verify.SecondJSONUnlessEquivalent(d.Get("statement").(string), statementJSON)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "while setting statement (%s), encountered: %s", statementToSet, err)
+	}
+
+	statementToSet, err = structure.NormalizeJsonString(statementToSet)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "statement (%s) is invalid JSON: %s", statementToSet, err)
+	}
+
+	d.Set("policy_name", policyName)
+	d.Set("statement", statementToSet)
+	d.Set("statement_id", sid)
+
+	return diags
+}
+
+func resourceResourcePolicyStatementDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LogsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), resourcePolicyStatementResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	policyName, sid := parts[0], parts[1]
+
+	mutexKey := resourcePolicyStatementMutexKey(policyName)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	policy, err := findResourcePolicyDocument(ctx, conn, policyName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudWatch Logs Resource Policy (%s): %s", policyName, err)
+	}
+
+	if !policy.removeStatement(sid) {
+		return diags
+	}
+
+	// The last statement in a shared policy takes the policy with it.
+	if len(policy.Statement) == 0 {
+		log.Printf("[DEBUG] Deleting CloudWatch Logs Resource Policy: %s", policyName)
+		_, err = conn.DeleteResourcePolicy(ctx, &cloudwatchlogs.DeleteResourcePolicyInput{
+			PolicyName: aws.String(policyName),
+		})
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			return diags
+		}
+	} else {
+		log.Printf("[DEBUG] Deleting CloudWatch Logs Resource Policy Statement: %s", d.Id())
+		err = putResourcePolicyDocument(ctx, conn, policyName, policy)
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CloudWatch Logs Resource Policy Statement (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourcePolicyStatementMutexKey(policyName string) string {
+	return "cloudwatch_log_resource_policy_" + policyName
+}
+
+type resourcePolicyDocument struct {
+	Version   string                   `json:",omitempty"`
+	ID        string                   `json:"Id,omitempty"`
+	Statement []map[string]interface{} `json:"Statement"`
+}
+
+func (p *resourcePolicyDocument) hasStatement(sid string) bool {
+	for _, v := range p.Statement {
+		if v["Sid"] == sid {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p *resourcePolicyDocument) upsertStatement(statement map[string]interface{}) {
+	for i, v := range p.Statement {
+		if v["Sid"] == statement["Sid"] {
+			p.Statement[i] = statement
+			return
+		}
+	}
+
+	p.Statement = append(p.Statement, statement)
+}
+
+func (p *resourcePolicyDocument) removeStatement(sid string) bool {
+	for i, v := range p.Statement {
+		if v["Sid"] == sid {
+			p.Statement = append(p.Statement[:i], p.Statement[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+// findResourcePolicyDocument returns the named policy's document, or an empty document if the policy does not yet exist.
+func findResourcePolicyDocument(ctx context.Context, conn *cloudwatchlogs.Client, name string) (*resourcePolicyDocument, error) {
+	policy := &resourcePolicyDocument{
+		Version: "2012-10-17",
+	}
+
+	resourcePolicy, err := findResourcePolicyByName(ctx, conn, name)
+
+	if tfresource.NotFound(err) {
+		return policy, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := decodeResourcePolicyDocument(aws.ToString(resourcePolicy.PolicyDocument), policy); err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+func findResourcePolicyStatementByTwoPartKey(ctx context.Context, conn *cloudwatchlogs.Client, policyName, sid string) (map[string]interface{}, error) {
+	resourcePolicy, err := findResourcePolicyByName(ctx, conn, policyName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var policy resourcePolicyDocument
+	if err := decodeResourcePolicyDocument(aws.ToString(resourcePolicy.PolicyDocument), &policy); err != nil {
+		return nil, err
+	}
+
+	for _, v := range policy.Statement {
+		if v["Sid"] == sid {
+			return v, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		Message: fmt.Sprintf("statement %q not found in CloudWatch Logs Resource Policy (%s)", sid, policyName),
+	}
+}
+
+// decodeResourcePolicyDocument decodes a policy document, accepting a Statement that is either a single object or a list.
+func decodeResourcePolicyDocument(s string, policy *resourcePolicyDocument) error {
+	var raw struct {
+		Version   string      `json:",omitempty"`
+		ID        string      `json:"Id,omitempty"`
+		Statement interface{} `json:"Statement"`
+	}
+
+	if err := tfjson.DecodeFromString(s, &raw); err != nil {
+		return fmt.Errorf("decoding policy document: %w", err)
+	}
+
+	if raw.Version != "" {
+		policy.Version = raw.Version
+	}
+	policy.ID = raw.ID
+	policy.Statement = nil
+
+	switch v := raw.Statement.(type) {
+	case map[string]interface{}:
+		policy.Statement = append(policy.Statement, v)
+	case []interface{}:
+		for _, v := range v {
+			if v, ok := v.(map[string]interface{}); ok {
+				policy.Statement = append(policy.Statement, v)
+			}
+		}
+	}
+
+	return nil
+}
+
+func putResourcePolicyDocument(ctx context.Context, conn *cloudwatchlogs.Client, name string, policy *resourcePolicyDocument) error {
+	document, err := tfjson.EncodeToString(policy)
+
+	if err != nil {
+		return err
+	}
+
+	if n := len(document); n > resourcePolicyDocumentMaxLength {
+		return fmt.Errorf("merged policy document is %d characters, exceeding the %d character limit", n, resourcePolicyDocumentMaxLength)
+	}
+
+	_, err = conn.PutResourcePolicy(ctx, &cloudwatchlogs.PutResourcePolicyInput{
+		PolicyDocument: aws.String(document),
+		PolicyName:     aws.String(name),
+	})
+
+	return err
+}
@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tflogs "github.com/hashicorp/terraform-provider-aws/internal/service/logs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLogsResourcePolicyStatement_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName1 := "aws_cloudwatch_log_resource_policy_statement.test1"
+	resourceName2 := "aws_cloudwatch_log_resource_policy_statement.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourcePolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePolicyStatementConfig_basic(rName, "rds"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourcePolicyStatementExists(ctx, resourceName1),
+					testAccCheckResourcePolicyStatementExists(ctx, resourceName2),
+					resource.TestCheckResourceAttr(resourceName1, "policy_name", rName),
+					resource.TestCheckResourceAttr(resourceName1, "statement_id", "Route53"),
+					resource.TestCheckResourceAttr(resourceName2, "policy_name", rName),
+					resource.TestCheckResourceAttr(resourceName2, "statement_id", "RDS"),
+					resource.TestCheckResourceAttrSet(resourceName2, "statement"),
+				),
+			},
+			{
+				ResourceName:      resourceName1,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResourcePolicyStatementConfig_basic(rName, "rds-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourcePolicyStatementExists(ctx, resourceName1),
+					testAccCheckResourcePolicyStatementExists(ctx, resourceName2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLogsResourcePolicyStatement_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_log_resource_policy_statement.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourcePolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePolicyStatementConfig_basic(rName, "rds"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourcePolicyStatementExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflogs.ResourceResourcePolicyStatement(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccLogsResourcePolicyStatement_duplicateStatementID(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourcePolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourcePolicyStatementConfig_duplicateStatementID(rName),
+				ExpectError: regexache.MustCompile(`statement already exists`),
+			},
+		},
+	})
+}
+
+func testAccCheckResourcePolicyStatementExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LogsClient(ctx)
+
+		_, err = tflogs.FindResourcePolicyStatementByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+		return err
+	}
+}
+
+func testAccCheckResourcePolicyStatementDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LogsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cloudwatch_log_resource_policy_statement" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+			if err != nil {
+				return err
+			}
+
+			_, err = tflogs.FindResourcePolicyStatementByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CloudWatch Logs Resource Policy Statement still exists: %s", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccResourcePolicyStatementConfig_basic(rName, logGroupSuffix string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_cloudwatch_log_resource_policy_statement" "test1" {
+  policy_name  = %[1]q
+  statement_id = "Route53"
+
+  statement = jsonencode({
+    Effect = "Allow"
+    Action = [
+      "logs:CreateLogStream",
+      "logs:PutLogEvents",
+    ]
+    Principal = {
+      Service = "route53.${data.aws_partition.current.dns_suffix}"
+    }
+    Resource = "arn:${data.aws_partition.current.partition}:logs:*:*:log-group:/aws/route53/*"
+  })
+}
+
+resource "aws_cloudwatch_log_resource_policy_statement" "test2" {
+  policy_name  = %[1]q
+  statement_id = "RDS"
+
+  statement = jsonencode({
+    Effect = "Allow"
+    Action = [
+      "logs:CreateLogStream",
+      "logs:PutLogEvents",
+    ]
+    Principal = {
+      Service = "rds.${data.aws_partition.current.dns_suffix}"
+    }
+    Resource = "arn:${data.aws_partition.current.partition}:logs:*:*:log-group:/aws/%[2]s/*"
+  })
+}
+`, rName, logGroupSuffix)
+}
+
+func testAccResourcePolicyStatementConfig_duplicateStatementID(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_cloudwatch_log_resource_policy_statement" "test1" {
+  policy_name  = %[1]q
+  statement_id = "Route53"
+
+  statement = jsonencode({
+    Effect = "Allow"
+    Action = [
+      "logs:CreateLogStream",
+      "logs:PutLogEvents",
+    ]
+    Principal = {
+      Service = "route53.${data.aws_partition.current.dns_suffix}"
+    }
+    Resource = "arn:${data.aws_partition.current.partition}:logs:*:*:log-group:/aws/route53/*"
+  })
+}
+
+resource "aws_cloudwatch_log_resource_policy_statement" "test2" {
+  policy_name  = aws_cloudwatch_log_resource_policy_statement.test1.policy_name
+  statement_id = aws_cloudwatch_log_resource_policy_statement.test1.statement_id
+
+  statement = jsonencode({
+    Effect = "Allow"
+    Action = [
+      "logs:CreateLogStream",
+      "logs:PutLogEvents",
+    ]
+    Principal = {
+      Service = "rds.${data.aws_partition.current.dns_suffix}"
+    }
+    Resource = "arn:${data.aws_partition.current.partition}:logs:*:*:log-group:/aws/rds/*"
+  })
+}
+`, rName)
+}
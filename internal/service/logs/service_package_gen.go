@@ -82,6 +82,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceResourcePolicy,
 			TypeName: "aws_cloudwatch_log_resource_policy",
 		},
+		{
+			Factory:  resourceResourcePolicyStatement,
+			TypeName: "aws_cloudwatch_log_resource_policy_statement",
+			Name:     "Resource Policy Statement",
+		},
 		{
 			Factory:  resourceStream,
 			TypeName: "aws_cloudwatch_log_stream",
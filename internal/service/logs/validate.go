@@ -8,6 +8,7 @@ import (
 
 	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
 )
 
 func validResourcePolicyDocument(v interface{}, k string) (ws []string, errors []error) {
@@ -22,6 +23,18 @@ func validResourcePolicyDocument(v interface{}, k string) (ws []string, errors [
 	return
 }
 
+func validResourcePolicyStatement(v interface{}, k string) (ws []string, errors []error) {
+	var statement map[string]interface{}
+	if err := tfjson.DecodeFromString(v.(string), &statement); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a JSON object: %s", k, err))
+		return
+	}
+	if _, ok := statement["Sid"]; ok {
+		errors = append(errors, fmt.Errorf("%q must not contain a Sid, it is set from statement_id", k))
+	}
+	return
+}
+
 func validAccountPolicyDocument(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	// https://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_PutAccountPolicy.html
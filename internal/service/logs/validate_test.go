@@ -179,3 +179,30 @@ func TestValidStreamName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidResourcePolicyStatement(t *testing.T) {
+	t.Parallel()
+
+	validStatements := []string{
+		`{"Effect":"Allow","Action":"logs:PutLogEvents","Principal":{"Service":"rds.amazonaws.com"},"Resource":"*"}`,
+		`{}`,
+	}
+	for _, v := range validStatements {
+		_, errors := validResourcePolicyStatement(v, "statement")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid statement: %q", v, errors)
+		}
+	}
+
+	invalidStatements := []string{
+		`{"Sid":"Test","Effect":"Allow","Action":"logs:PutLogEvents","Resource":"*"}`,
+		`[{"Effect":"Allow"}]`,
+		`not json`,
+	}
+	for _, v := range invalidStatements {
+		_, errors := validResourcePolicyStatement(v, "statement")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid statement", v)
+		}
+	}
+}
@@ -5,7 +5,11 @@ package mediaconvert
 
 // Exports for use in tests only.
 var (
-	ResourceQueue = resourceQueue
+	ResourceJobTemplate = resourceJobTemplate
+	ResourcePreset      = resourcePreset
+	ResourceQueue       = resourceQueue
 
-	FindQueueByName = findQueueByName
+	FindJobTemplateByName = findJobTemplateByName
+	FindPresetByName      = findPresetByName
+	FindQueueByName       = findQueueByName
 )
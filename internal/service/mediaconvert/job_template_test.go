@@ -0,0 +1,291 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconvert_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconvert/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediaconvert "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaConvertJobTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var jobTemplate types.JobTemplate
+	resourceName := "aws_media_convert_job_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConvertServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobTemplateConfig_basic(rName, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobTemplateExists(ctx, resourceName, &jobTemplate),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "mediaconvert", regexache.MustCompile(`jobTemplates/.+`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPriority, acctest.Ct0),
+					resource.TestCheckResourceAttrSet(resourceName, "queue"),
+					resource.TestCheckResourceAttrSet(resourceName, "settings"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Settings are stored in their normalized form.
+				ImportStateVerifyIgnore: []string{"settings"},
+			},
+			{
+				Config: testAccJobTemplateConfig_basic(rName, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobTemplateExists(ctx, resourceName, &jobTemplate),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPriority, "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConvertJobTemplate_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var jobTemplate types.JobTemplate
+	resourceName := "aws_media_convert_job_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConvertServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobTemplateConfig_basic(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobTemplateExists(ctx, resourceName, &jobTemplate),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfmediaconvert.ResourceJobTemplate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaConvertJobTemplate_queue(t *testing.T) {
+	ctx := acctest.Context(t)
+	var jobTemplate types.JobTemplate
+	resourceName := "aws_media_convert_job_template.test"
+	queueResourceName := "aws_media_convert_queue.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConvertServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobTemplateConfig_queue(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobTemplateExists(ctx, resourceName, &jobTemplate),
+					resource.TestCheckResourceAttr(resourceName, "acceleration_settings.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "acceleration_settings.0.mode", string(types.AccelerationModeDisabled)),
+					resource.TestCheckResourceAttrPair(resourceName, "queue", queueResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "status_update_interval", string(types.StatusUpdateIntervalSeconds60)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConvertJobTemplate_hopDestination(t *testing.T) {
+	ctx := acctest.Context(t)
+	var jobTemplate types.JobTemplate
+	resourceName := "aws_media_convert_job_template.test"
+	queueResourceName := "aws_media_convert_queue.hop"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConvertServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobTemplateConfig_hopDestination(rName, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobTemplateExists(ctx, resourceName, &jobTemplate),
+					resource.TestCheckResourceAttr(resourceName, "hop_destination.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "hop_destination.0.priority", "10"),
+					resource.TestCheckResourceAttrPair(resourceName, "hop_destination.0.queue", queueResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "hop_destination.0.wait_minutes", "5"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccJobTemplateConfig_hopDestination(rName, 15),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobTemplateExists(ctx, resourceName, &jobTemplate),
+					resource.TestCheckResourceAttr(resourceName, "hop_destination.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "hop_destination.0.wait_minutes", "15"),
+				),
+			},
+			{
+				Config: testAccJobTemplateConfig_queue(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobTemplateExists(ctx, resourceName, &jobTemplate),
+					resource.TestCheckResourceAttr(resourceName, "hop_destination.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJobTemplateDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_media_convert_job_template" {
+				continue
+			}
+
+			conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConvertClient(ctx)
+
+			_, err := tfmediaconvert.FindJobTemplateByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Media Convert Job Template %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckJobTemplateExists(ctx context.Context, n string, v *types.JobTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConvertClient(ctx)
+
+		output, err := tfmediaconvert.FindJobTemplateByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+const testAccJobTemplateSettings = `
+  settings = jsonencode({
+    outputGroups = [{
+      outputGroupSettings = {
+        type = "FILE_GROUP_SETTINGS"
+        fileGroupSettings = {}
+      }
+      outputs = [{
+        containerSettings = {
+          container = "MP4"
+        }
+        videoDescription = {
+          codecSettings = {
+            codec = "H_264"
+            h264Settings = {
+              rateControlMode = "QVBR"
+              maxBitrate      = 5000000
+            }
+          }
+        }
+      }]
+    }]
+  })
+`
+
+func testAccJobTemplateConfig_basic(rName string, priority int) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_job_template" "test" {
+  name     = %[1]q
+  priority = %[2]d
+%[3]s
+}
+`, rName, priority, testAccJobTemplateSettings)
+}
+
+func testAccJobTemplateConfig_queue(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_queue" "test" {
+  name = %[1]q
+}
+
+resource "aws_media_convert_job_template" "test" {
+  name                   = %[1]q
+  queue                  = aws_media_convert_queue.test.arn
+  status_update_interval = "SECONDS_60"
+
+  acceleration_settings {
+    mode = "DISABLED"
+  }
+%[2]s
+}
+`, rName, testAccJobTemplateSettings)
+}
+
+func testAccJobTemplateConfig_hopDestination(rName string, waitMinutes int) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_queue" "test" {
+  name = %[1]q
+}
+
+resource "aws_media_convert_queue" "hop" {
+  name = "%[1]s-hop"
+}
+
+resource "aws_media_convert_job_template" "test" {
+  name                   = %[1]q
+  queue                  = aws_media_convert_queue.test.arn
+  status_update_interval = "SECONDS_60"
+
+  acceleration_settings {
+    mode = "DISABLED"
+  }
+
+  hop_destination {
+    priority     = 10
+    queue        = aws_media_convert_queue.hop.arn
+    wait_minutes = %[2]d
+  }
+%[3]s
+}
+`, rName, waitMinutes, testAccJobTemplateSettings)
+}
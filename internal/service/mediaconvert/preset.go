@@ -0,0 +1,260 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconvert
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconvert"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconvert/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_media_convert_preset", name="Preset")
+// @Tags(identifierAttribute="arn")
+func resourcePreset() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePresetCreate,
+		ReadWithoutTimeout:   resourcePresetRead,
+		UpdateWithoutTimeout: resourcePresetUpdate,
+		DeleteWithoutTimeout: resourcePresetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"category": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"settings": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentSettingsDiffs[types.PresetSettings],
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourcePresetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConvertClient(ctx)
+
+	settings, err := expandSettings[types.PresetSettings](d.Get("settings").(string))
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	name := d.Get(names.AttrName).(string)
+	input := &mediaconvert.CreatePresetInput{
+		Name:     aws.String(name),
+		Settings: settings,
+		Tags:     getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("category"); ok {
+		input.Category = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreatePreset(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Media Convert Preset (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Preset.Name))
+
+	return append(diags, resourcePresetRead(ctx, d, meta)...)
+}
+
+func resourcePresetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConvertClient(ctx)
+
+	preset, err := findPresetByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Media Convert Preset (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Media Convert Preset (%s): %s", d.Id(), err)
+	}
+
+	settings, err := flattenSettings(preset.Settings)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.Set(names.AttrARN, preset.Arn)
+	d.Set("category", preset.Category)
+	d.Set(names.AttrDescription, preset.Description)
+	d.Set(names.AttrName, preset.Name)
+	d.Set("settings", settings)
+
+	return diags
+}
+
+func resourcePresetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConvertClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		settings, err := expandSettings[types.PresetSettings](d.Get("settings").(string))
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input := &mediaconvert.UpdatePresetInput{
+			Category:    aws.String(d.Get("category").(string)),
+			Description: aws.String(d.Get(names.AttrDescription).(string)),
+			Name:        aws.String(d.Id()),
+			Settings:    settings,
+		}
+
+		_, err = conn.UpdatePreset(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Media Convert Preset (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePresetRead(ctx, d, meta)...)
+}
+
+func resourcePresetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConvertClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Media Convert Preset: %s", d.Id())
+	_, err := conn.DeletePreset(ctx, &mediaconvert.DeletePresetInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.NotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Media Convert Preset (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findPresetByName(ctx context.Context, conn *mediaconvert.Client, name string) (*types.Preset, error) {
+	input := &mediaconvert.GetPresetInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetPreset(ctx, input)
+
+	if errs.IsA[*types.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Preset == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Preset, nil
+}
+
+// expandSettings decodes a JSON settings document into the corresponding API structure.
+// Member names are matched case-insensitively, so both the API's camelCase
+// representation and the SDK's field names are accepted.
+func expandSettings[T any](s string) (*T, error) {
+	var apiObject T
+
+	if err := tfjson.DecodeFromString(s, &apiObject); err != nil {
+		return nil, err
+	}
+
+	return &apiObject, nil
+}
+
+func flattenSettings(apiObject any) (string, error) {
+	b, err := json.Marshal(apiObject)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(tfjson.RemoveEmptyFields(b)), nil
+}
+
+// suppressEquivalentSettingsDiffs suppresses differences between settings documents
+// that decode to the same API structure.
+func suppressEquivalentSettingsDiffs[T any](k, old, new string, d *schema.ResourceData) bool {
+	normalize := func(s string) (string, error) {
+		apiObject, err := expandSettings[T](s)
+
+		if err != nil {
+			return "", err
+		}
+
+		return flattenSettings(apiObject)
+	}
+
+	oldSettings, err := normalize(old)
+	if err != nil {
+		return false
+	}
+
+	newSettings, err := normalize(new)
+	if err != nil {
+		return false
+	}
+
+	return tfjson.EqualStrings(oldSettings, newSettings)
+}
@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconvert_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconvert/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediaconvert "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaConvertPreset_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var preset types.Preset
+	resourceName := "aws_media_convert_preset.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConvertServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPresetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPresetConfig_basic(rName, 5000000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPresetExists(ctx, resourceName, &preset),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "mediaconvert", regexache.MustCompile(`presets/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "category", ""),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrSet(resourceName, "settings"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Settings are stored in their normalized form.
+				ImportStateVerifyIgnore: []string{"settings"},
+			},
+			{
+				Config: testAccPresetConfig_basic(rName, 8000000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPresetExists(ctx, resourceName, &preset),
+					resource.TestMatchResourceAttr(resourceName, "settings", regexache.MustCompile(`8000000`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConvertPreset_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var preset types.Preset
+	resourceName := "aws_media_convert_preset.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConvertServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPresetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPresetConfig_basic(rName, 5000000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPresetExists(ctx, resourceName, &preset),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfmediaconvert.ResourcePreset(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaConvertPreset_description(t *testing.T) {
+	ctx := acctest.Context(t)
+	var preset types.Preset
+	resourceName := "aws_media_convert_preset.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConvertServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPresetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPresetConfig_description(rName, "test category", "test description"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPresetExists(ctx, resourceName, &preset),
+					resource.TestCheckResourceAttr(resourceName, "category", "test category"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "test description"),
+				),
+			},
+			{
+				Config: testAccPresetConfig_description(rName, "updated category", "updated description"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPresetExists(ctx, resourceName, &preset),
+					resource.TestCheckResourceAttr(resourceName, "category", "updated category"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated description"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPresetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_media_convert_preset" {
+				continue
+			}
+
+			conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConvertClient(ctx)
+
+			_, err := tfmediaconvert.FindPresetByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Media Convert Preset %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPresetExists(ctx context.Context, n string, v *types.Preset) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConvertClient(ctx)
+
+		output, err := tfmediaconvert.FindPresetByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPresetConfig_basic(rName string, maxBitrate int) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_preset" "test" {
+  name = %[1]q
+
+  settings = jsonencode({
+    containerSettings = {
+      container = "MP4"
+    }
+    videoDescription = {
+      codecSettings = {
+        codec = "H_264"
+        h264Settings = {
+          rateControlMode = "QVBR"
+          maxBitrate      = %[2]d
+        }
+      }
+    }
+  })
+}
+`, rName, maxBitrate)
+}
+
+func testAccPresetConfig_description(rName, category, description string) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_preset" "test" {
+  name        = %[1]q
+  category    = %[2]q
+  description = %[3]q
+
+  settings = jsonencode({
+    containerSettings = {
+      container = "MP4"
+    }
+    videoDescription = {
+      codecSettings = {
+        codec = "H_264"
+        h264Settings = {
+          rateControlMode = "QVBR"
+          maxBitrate      = 5000000
+        }
+      }
+    }
+  })
+}
+`, rName, category, description)
+}
@@ -37,6 +37,22 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceJobTemplate,
+			TypeName: "aws_media_convert_job_template",
+			Name:     "Job Template",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  resourcePreset,
+			TypeName: "aws_media_convert_preset",
+			Name:     "Preset",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  resourceQueue,
 			TypeName: "aws_media_convert_queue",
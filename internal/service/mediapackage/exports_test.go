@@ -5,5 +5,8 @@ package mediapackage
 
 // Exports for use in tests only.
 var (
-	FindChannelByID = findChannelByID
+	ResourceOriginEndpoint = resourceOriginEndpoint
+
+	FindChannelByID        = findChannelByID
+	FindOriginEndpointByID = findOriginEndpointByID
 )
@@ -0,0 +1,472 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackage
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediapackage"
+	"github.com/aws/aws-sdk-go-v2/service/mediapackage/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_media_package_origin_endpoint", name="Origin Endpoint")
+// @Tags(identifierAttribute="arn")
+func resourceOriginEndpoint() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceOriginEndpointCreate,
+		ReadWithoutTimeout:   resourceOriginEndpointRead,
+		UpdateWithoutTimeout: resourceOriginEndpointUpdate,
+		DeleteWithoutTimeout: resourceOriginEndpointDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"channel_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"dash_package": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"dash_package", "hls_package"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"manifest_window_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"min_buffer_time_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"min_update_period_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"profile": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[types.Profile](),
+						},
+						"segment_duration_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"suggested_presentation_delay_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"endpoint_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[\w-]+$`), "must only contain alphanumeric characters, dashes or underscores"),
+			},
+			"hls_package": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"dash_package", "hls_package"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ad_markers": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[types.AdMarkers](),
+						},
+						"include_iframe_only_stream": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"playlist_type": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[types.PlaylistType](),
+						},
+						"playlist_window_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"program_date_time_interval_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"segment_duration_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"use_audio_rendition_group": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"manifest_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"origination": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          types.OriginationAllow,
+				ValidateDiagFunc: enum.Validate[types.Origination](),
+			},
+			"startover_window_seconds": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"time_delay_seconds": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			names.AttrURL: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"whitelist": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidCIDRNetworkAddress,
+				},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceOriginEndpointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaPackageClient(ctx)
+
+	id := d.Get("endpoint_id").(string)
+	input := &mediapackage.CreateOriginEndpointInput{
+		ChannelId:   aws.String(d.Get("channel_id").(string)),
+		Id:          aws.String(id),
+		Origination: types.Origination(d.Get("origination").(string)),
+		Tags:        getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("dash_package"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DashPackage = expandDashPackage(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("hls_package"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.HlsPackage = expandHLSPackage(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("manifest_name"); ok {
+		input.ManifestName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("startover_window_seconds"); ok {
+		input.StartoverWindowSeconds = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("time_delay_seconds"); ok {
+		input.TimeDelaySeconds = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("whitelist"); ok && v.(*schema.Set).Len() > 0 {
+		input.Whitelist = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	output, err := conn.CreateOriginEndpoint(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating MediaPackage Origin Endpoint (%s): %s", id, err)
+	}
+
+	d.SetId(aws.ToString(output.Id))
+
+	return append(diags, resourceOriginEndpointRead(ctx, d, meta)...)
+}
+
+func resourceOriginEndpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaPackageClient(ctx)
+
+	output, err := findOriginEndpointByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaPackage Origin Endpoint (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading MediaPackage Origin Endpoint (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.Arn)
+	d.Set("channel_id", output.ChannelId)
+	if output.DashPackage != nil {
+		if err := d.Set("dash_package", []interface{}{flattenDashPackage(output.DashPackage)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting dash_package: %s", err)
+		}
+	} else {
+		d.Set("dash_package", nil)
+	}
+	d.Set(names.AttrDescription, output.Description)
+	d.Set("endpoint_id", output.Id)
+	if output.HlsPackage != nil {
+		if err := d.Set("hls_package", []interface{}{flattenHLSPackage(output.HlsPackage)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting hls_package: %s", err)
+		}
+	} else {
+		d.Set("hls_package", nil)
+	}
+	d.Set("manifest_name", output.ManifestName)
+	d.Set("origination", output.Origination)
+	d.Set("startover_window_seconds", output.StartoverWindowSeconds)
+	d.Set("time_delay_seconds", output.TimeDelaySeconds)
+	d.Set(names.AttrURL, output.Url)
+	d.Set("whitelist", output.Whitelist)
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceOriginEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaPackageClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &mediapackage.UpdateOriginEndpointInput{
+			Description:            aws.String(d.Get(names.AttrDescription).(string)),
+			Id:                     aws.String(d.Id()),
+			Origination:            types.Origination(d.Get("origination").(string)),
+			StartoverWindowSeconds: aws.Int32(int32(d.Get("startover_window_seconds").(int))),
+			TimeDelaySeconds:       aws.Int32(int32(d.Get("time_delay_seconds").(int))),
+			Whitelist:              flex.ExpandStringValueSet(d.Get("whitelist").(*schema.Set)),
+		}
+
+		if v, ok := d.GetOk("dash_package"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.DashPackage = expandDashPackage(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("hls_package"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.HlsPackage = expandHLSPackage(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("manifest_name"); ok {
+			input.ManifestName = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateOriginEndpoint(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating MediaPackage Origin Endpoint (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceOriginEndpointRead(ctx, d, meta)...)
+}
+
+func resourceOriginEndpointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaPackageClient(ctx)
+
+	log.Printf("[DEBUG] Deleting MediaPackage Origin Endpoint: %s", d.Id())
+	_, err := conn.DeleteOriginEndpoint(ctx, &mediapackage.DeleteOriginEndpointInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.NotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting MediaPackage Origin Endpoint (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findOriginEndpointByID(ctx context.Context, conn *mediapackage.Client, id string) (*mediapackage.DescribeOriginEndpointOutput, error) {
+	input := &mediapackage.DescribeOriginEndpointInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.DescribeOriginEndpoint(ctx, input)
+
+	if errs.IsA[*types.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandDashPackage(tfMap map[string]interface{}) *types.DashPackage {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.DashPackage{}
+
+	if v, ok := tfMap["manifest_window_seconds"].(int); ok && v != 0 {
+		apiObject.ManifestWindowSeconds = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["min_buffer_time_seconds"].(int); ok && v != 0 {
+		apiObject.MinBufferTimeSeconds = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["min_update_period_seconds"].(int); ok && v != 0 {
+		apiObject.MinUpdatePeriodSeconds = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["profile"].(string); ok && v != "" {
+		apiObject.Profile = types.Profile(v)
+	}
+
+	if v, ok := tfMap["segment_duration_seconds"].(int); ok && v != 0 {
+		apiObject.SegmentDurationSeconds = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["suggested_presentation_delay_seconds"].(int); ok && v != 0 {
+		apiObject.SuggestedPresentationDelaySeconds = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func flattenDashPackage(apiObject *types.DashPackage) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"manifest_window_seconds":              aws.ToInt32(apiObject.ManifestWindowSeconds),
+		"min_buffer_time_seconds":              aws.ToInt32(apiObject.MinBufferTimeSeconds),
+		"min_update_period_seconds":            aws.ToInt32(apiObject.MinUpdatePeriodSeconds),
+		"profile":                              apiObject.Profile,
+		"segment_duration_seconds":             aws.ToInt32(apiObject.SegmentDurationSeconds),
+		"suggested_presentation_delay_seconds": aws.ToInt32(apiObject.SuggestedPresentationDelaySeconds),
+	}
+
+	return tfMap
+}
+
+func expandHLSPackage(tfMap map[string]interface{}) *types.HlsPackage {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.HlsPackage{}
+
+	if v, ok := tfMap["ad_markers"].(string); ok && v != "" {
+		apiObject.AdMarkers = types.AdMarkers(v)
+	}
+
+	if v, ok := tfMap["include_iframe_only_stream"].(bool); ok {
+		apiObject.IncludeIframeOnlyStream = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["playlist_type"].(string); ok && v != "" {
+		apiObject.PlaylistType = types.PlaylistType(v)
+	}
+
+	if v, ok := tfMap["playlist_window_seconds"].(int); ok && v != 0 {
+		apiObject.PlaylistWindowSeconds = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["program_date_time_interval_seconds"].(int); ok && v != 0 {
+		apiObject.ProgramDateTimeIntervalSeconds = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["segment_duration_seconds"].(int); ok && v != 0 {
+		apiObject.SegmentDurationSeconds = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["use_audio_rendition_group"].(bool); ok {
+		apiObject.UseAudioRenditionGroup = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenHLSPackage(apiObject *types.HlsPackage) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"ad_markers":                         apiObject.AdMarkers,
+		"include_iframe_only_stream":         aws.ToBool(apiObject.IncludeIframeOnlyStream),
+		"playlist_type":                      apiObject.PlaylistType,
+		"playlist_window_seconds":            aws.ToInt32(apiObject.PlaylistWindowSeconds),
+		"program_date_time_interval_seconds": aws.ToInt32(apiObject.ProgramDateTimeIntervalSeconds),
+		"segment_duration_seconds":           aws.ToInt32(apiObject.SegmentDurationSeconds),
+		"use_audio_rendition_group":          aws.ToBool(apiObject.UseAudioRenditionGroup),
+	}
+
+	return tfMap
+}
@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackage_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/mediapackage"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediapackage "github.com/hashicorp/terraform-provider-aws/internal/service/mediapackage"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaPackageOriginEndpoint_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_media_package_origin_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, strings.ToLower(mediapackage.ServiceID))
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, strings.ToLower(mediapackage.ServiceID)),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_hls(rName, 6),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "mediapackage", regexache.MustCompile(`origin_endpoints/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "channel_id", "aws_media_package_channel.test", "channel_id"),
+					resource.TestCheckResourceAttr(resourceName, "dash_package.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "hls_package.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "hls_package.0.segment_duration_seconds", "6"),
+					resource.TestCheckResourceAttr(resourceName, "origination", "ALLOW"),
+					resource.TestMatchResourceAttr(resourceName, names.AttrURL, regexache.MustCompile("^https://")),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOriginEndpointConfig_hls(rName, 4),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "hls_package.0.segment_duration_seconds", "4"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageOriginEndpoint_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_media_package_origin_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, strings.ToLower(mediapackage.ServiceID))
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, strings.ToLower(mediapackage.ServiceID)),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_hls(rName, 6),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfmediapackage.ResourceOriginEndpoint(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageOriginEndpoint_dash(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_media_package_origin_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, strings.ToLower(mediapackage.ServiceID))
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, strings.ToLower(mediapackage.ServiceID)),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_dash(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "dash_package.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "dash_package.0.profile", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, "hls_package.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "whitelist.#", acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckOriginEndpointDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_media_package_origin_endpoint" {
+				continue
+			}
+
+			_, err := tfmediapackage.FindOriginEndpointByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MediaPackage Origin Endpoint %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckOriginEndpointExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageClient(ctx)
+
+		_, err := tfmediapackage.FindOriginEndpointByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccOriginEndpointConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_media_package_channel" "test" {
+  channel_id = %[1]q
+}
+`, rName)
+}
+
+func testAccOriginEndpointConfig_hls(rName string, segmentDuration int) string {
+	return acctest.ConfigCompose(testAccOriginEndpointConfig_base(rName), fmt.Sprintf(`
+resource "aws_media_package_origin_endpoint" "test" {
+  channel_id  = aws_media_package_channel.test.channel_id
+  endpoint_id = %[1]q
+
+  hls_package {
+    segment_duration_seconds = %[2]d
+  }
+}
+`, rName, segmentDuration))
+}
+
+func testAccOriginEndpointConfig_dash(rName string) string {
+	return acctest.ConfigCompose(testAccOriginEndpointConfig_base(rName), fmt.Sprintf(`
+resource "aws_media_package_origin_endpoint" "test" {
+  channel_id  = aws_media_package_channel.test.channel_id
+  endpoint_id = %[1]q
+  whitelist   = ["10.0.0.0/16"]
+
+  dash_package {
+    profile = "NONE"
+  }
+}
+`, rName))
+}
@@ -148,10 +148,16 @@ func resourceOutboundConnectionCreate(ctx context.Context, d *schema.ResourceDat
 
 	d.SetId(aws.ToString(output.ConnectionId))
 
-	if _, err := waitOutboundConnectionCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	connection, err := waitOutboundConnectionCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for OpenSearch Outbound Connection (%s) create: %s", d.Id(), err)
 	}
 
+	if status := connection.ConnectionStatus; status.StatusCode == awstypes.OutboundConnectionStatusCodeValidationFailed {
+		return sdkdiag.AppendErrorf(diags, "OpenSearch Outbound Connection (%s) validation failed: %s", d.Id(), aws.ToString(status.Message))
+	}
+
 	if d.Get("accept_connection").(bool) {
 		input := &opensearch.AcceptInboundConnectionInput{
 			ConnectionId: aws.String(d.Id()),
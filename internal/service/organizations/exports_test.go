@@ -5,17 +5,19 @@ package organizations
 
 // Exports for use in tests only.
 var (
-	ResourceAccount                = resourceAccount
-	ResourceDelegatedAdministrator = resourceDelegatedAdministrator
-	ResourceOrganization           = resourceOrganization
-	ResourceOrganizationalUnit     = resourceOrganizationalUnit
-	ResourcePolicy                 = resourcePolicy
-	ResourcePolicyAttachment       = resourcePolicyAttachment
-	ResourceResourcePolicy         = resourceResourcePolicy
+	ResourceAccount                    = resourceAccount
+	ResourceDelegatedAdministrator     = resourceDelegatedAdministrator
+	ResourceOrganization               = resourceOrganization
+	ResourceOrganizationalUnit         = resourceOrganizationalUnit
+	ResourcePolicy                     = resourcePolicy
+	ResourcePolicyAttachment           = resourcePolicyAttachment
+	ResourcePolicyAttachmentsExclusive = resourcePolicyAttachmentsExclusive
+	ResourceResourcePolicy             = resourceResourcePolicy
 
-	FindAccountByID                  = findAccountByID
-	FindOrganizationalUnitByID       = findOrganizationalUnitByID
-	FindPolicyAttachmentByTwoPartKey = findPolicyAttachmentByTwoPartKey
-	FindPolicyByID                   = findPolicyByID
-	FindResourcePolicy               = findResourcePolicy
+	FindAccountByID                   = findAccountByID
+	FindAttachedPolicyIDsByTwoPartKey = findAttachedPolicyIDsByTwoPartKey
+	FindOrganizationalUnitByID        = findOrganizationalUnitByID
+	FindPolicyAttachmentByTwoPartKey  = findPolicyAttachmentByTwoPartKey
+	FindPolicyByID                    = findPolicyByID
+	FindResourcePolicy                = findResourcePolicy
 )
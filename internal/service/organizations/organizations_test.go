@@ -75,6 +75,10 @@ func TestAccOrganizations_serial(t *testing.T) {
 			"SkipDestroy":        testAccPolicyAttachment_skipDestroy,
 			acctest.CtDisappears: testAccPolicyAttachment_disappears,
 		},
+		"PolicyAttachmentsExclusive": {
+			acctest.CtBasic:     testAccPolicyAttachmentsExclusive_basic,
+			"OutOfBandAddition": testAccPolicyAttachmentsExclusive_outOfBandAddition,
+		},
 		"PolicyDataSource": {
 			"UnattachedPolicy": testAccPolicyDataSource_UnattachedPolicy,
 		},
@@ -0,0 +1,218 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_organizations_policy_attachments_exclusive", name="Policy Attachments Exclusive")
+func resourcePolicyAttachmentsExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePolicyAttachmentsExclusiveCreate,
+		ReadWithoutTimeout:   resourcePolicyAttachmentsExclusiveRead,
+		UpdateWithoutTimeout: resourcePolicyAttachmentsExclusiveUpdate,
+		DeleteWithoutTimeout: resourcePolicyAttachmentsExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"policy_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.PolicyType](),
+			},
+			"target_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourcePolicyAttachmentsExclusiveCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OrganizationsClient(ctx)
+
+	targetID := d.Get("target_id").(string)
+	policyType := d.Get("policy_type").(string)
+	id := policyAttachmentsExclusiveCreateResourceID(targetID, policyType)
+
+	if err := syncPolicyAttachments(ctx, conn, targetID, awstypes.PolicyType(policyType), flex.ExpandStringValueSet(d.Get("policy_ids").(*schema.Set))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Organizations Policy Attachments Exclusive (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourcePolicyAttachmentsExclusiveRead(ctx, d, meta)...)
+}
+
+func resourcePolicyAttachmentsExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OrganizationsClient(ctx)
+
+	targetID, policyType, err := policyAttachmentsExclusiveParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	policyIDs, err := findAttachedPolicyIDsByTwoPartKey(ctx, conn, targetID, awstypes.PolicyType(policyType))
+
+	if !d.IsNewResource() && errs.IsA[*awstypes.TargetNotFoundException](err) {
+		log.Printf("[WARN] Organizations Policy Attachments Exclusive %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Organizations Policy Attachments Exclusive (%s): %s", d.Id(), err)
+	}
+
+	d.Set("policy_ids", policyIDs)
+	d.Set("policy_type", policyType)
+	d.Set("target_id", targetID)
+
+	return diags
+}
+
+func resourcePolicyAttachmentsExclusiveUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OrganizationsClient(ctx)
+
+	if d.HasChange("policy_ids") {
+		targetID, policyType, err := policyAttachmentsExclusiveParseResourceID(d.Id())
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		if err := syncPolicyAttachments(ctx, conn, targetID, awstypes.PolicyType(policyType), flex.ExpandStringValueSet(d.Get("policy_ids").(*schema.Set))); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Organizations Policy Attachments Exclusive (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePolicyAttachmentsExclusiveRead(ctx, d, meta)...)
+}
+
+func resourcePolicyAttachmentsExclusiveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Exclusive management is released. Attached policies are left in place.
+	log.Printf("[DEBUG] Removing Organizations Policy Attachments Exclusive from state: %s", d.Id())
+
+	return diags
+}
+
+const policyAttachmentsExclusiveResourceIDSeparator = ":"
+
+func policyAttachmentsExclusiveCreateResourceID(targetID, policyType string) string {
+	parts := []string{targetID, policyType}
+	id := strings.Join(parts, policyAttachmentsExclusiveResourceIDSeparator)
+
+	return id
+}
+
+func policyAttachmentsExclusiveParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, policyAttachmentsExclusiveResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected TARGETID%[2]sPOLICYTYPE", id, policyAttachmentsExclusiveResourceIDSeparator)
+}
+
+// syncPolicyAttachments makes the policies of the specified type attached to the target match want.
+// Missing policies are attached before unwanted policies are detached, so that targets which
+// require at least one policy of the type (for example service control policies) are never left without one.
+func syncPolicyAttachments(ctx context.Context, conn *organizations.Client, targetID string, policyType awstypes.PolicyType, want []string) error {
+	have, err := findAttachedPolicyIDsByTwoPartKey(ctx, conn, targetID, policyType)
+
+	if err != nil {
+		return err
+	}
+
+	attach, detach, _ := flex.DiffSlices(have, want, func(s1, s2 string) bool { return s1 == s2 })
+
+	for _, policyID := range attach {
+		input := &organizations.AttachPolicyInput{
+			PolicyId: aws.String(policyID),
+			TargetId: aws.String(targetID),
+		}
+
+		_, err := tfresource.RetryWhenIsA[*awstypes.FinalizingOrganizationException](ctx, organizationFinalizationTimeout, func() (interface{}, error) {
+			return conn.AttachPolicy(ctx, input)
+		})
+
+		if errs.IsA[*awstypes.DuplicatePolicyAttachmentException](err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("attaching policy (%s): %w", policyID, err)
+		}
+	}
+
+	for _, policyID := range detach {
+		input := &organizations.DetachPolicyInput{
+			PolicyId: aws.String(policyID),
+			TargetId: aws.String(targetID),
+		}
+
+		_, err := tfresource.RetryWhenIsA[*awstypes.FinalizingOrganizationException](ctx, organizationFinalizationTimeout, func() (interface{}, error) {
+			return conn.DetachPolicy(ctx, input)
+		})
+
+		if errs.IsA[*awstypes.PolicyNotAttachedException](err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("detaching policy (%s): %w", policyID, err)
+		}
+	}
+
+	return nil
+}
+
+func findAttachedPolicyIDsByTwoPartKey(ctx context.Context, conn *organizations.Client, targetID string, policyType awstypes.PolicyType) ([]string, error) {
+	input := &organizations.ListPoliciesForTargetInput{
+		Filter:   policyType,
+		TargetId: aws.String(targetID),
+	}
+
+	output, err := findPoliciesForTarget(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfslices.ApplyToAll(output, func(v awstypes.PolicySummary) string {
+		return aws.ToString(v.Id)
+	}), nil
+}
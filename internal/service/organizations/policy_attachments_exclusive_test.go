@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tforganizations "github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccPolicyAttachmentsExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_organizations_policy_attachments_exclusive.test"
+	policyResourceName := "aws_organizations_policy.test"
+	targetResourceName := "aws_organizations_organizational_unit.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckOrganizationsAccount(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyAttachmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyAttachmentsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyAttachmentsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "target_id", targetResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "policy_type", string(awstypes.PolicyTypeTagPolicy)),
+					resource.TestCheckResourceAttr(resourceName, "policy_ids.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "policy_ids.*", policyResourceName, names.AttrID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// A policy attached out of band should be detached.
+func testAccPolicyAttachmentsExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_organizations_policy_attachments_exclusive.test"
+	policyResourceName := "aws_organizations_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckOrganizationsAccount(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyAttachmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyAttachmentsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyAttachmentsExclusiveExists(ctx, resourceName),
+					testAccCheckPolicyAttachmentsExclusiveAttachPolicy(ctx, "aws_organizations_policy.other", "aws_organizations_organizational_unit.test"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccPolicyAttachmentsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyAttachmentsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "policy_ids.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "policy_ids.*", policyResourceName, names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPolicyAttachmentsExclusiveExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OrganizationsClient(ctx)
+
+		output, err := tforganizations.FindAttachedPolicyIDsByTwoPartKey(ctx, conn, rs.Primary.Attributes["target_id"], awstypes.PolicyType(rs.Primary.Attributes["policy_type"]))
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := fmt.Sprint(len(output)), rs.Primary.Attributes["policy_ids.#"]; got != want {
+			return fmt.Errorf("Organizations Policy Attachments Exclusive %s has %s attached policies, expected %s", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPolicyAttachmentsExclusiveAttachPolicy(ctx context.Context, policyResourceName, targetResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		policy, ok := s.RootModule().Resources[policyResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", policyResourceName)
+		}
+
+		target, ok := s.RootModule().Resources[targetResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", targetResourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OrganizationsClient(ctx)
+
+		_, err := conn.AttachPolicy(ctx, &organizations.AttachPolicyInput{
+			PolicyId: aws.String(policy.Primary.ID),
+			TargetId: aws.String(target.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccPolicyAttachmentsExclusiveConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_organization" "test" {
+  enabled_policy_types = ["TAG_POLICY"]
+}
+
+resource "aws_organizations_organizational_unit" "test" {
+  name      = %[1]q
+  parent_id = aws_organizations_organization.test.roots[0].id
+}
+
+resource "aws_organizations_policy" "test" {
+  depends_on = [aws_organizations_organization.test]
+
+  name = %[1]q
+  type = "TAG_POLICY"
+  content = jsonencode({
+    tags = {
+      Product = {
+        tag_key = {
+          "@@assign" = "Product"
+        }
+      }
+    }
+  })
+}
+
+resource "aws_organizations_policy" "other" {
+  depends_on = [aws_organizations_organization.test]
+
+  name = "${%[1]q}-other"
+  type = "TAG_POLICY"
+  content = jsonencode({
+    tags = {
+      Owner = {
+        tag_key = {
+          "@@assign" = "Owner"
+        }
+      }
+    }
+  })
+}
+
+resource "aws_organizations_policy_attachment" "test" {
+  policy_id = aws_organizations_policy.test.id
+  target_id = aws_organizations_organizational_unit.test.id
+}
+
+resource "aws_organizations_policy_attachments_exclusive" "test" {
+  target_id   = aws_organizations_organizational_unit.test.id
+  policy_type = "TAG_POLICY"
+  policy_ids  = [aws_organizations_policy_attachment.test.policy_id]
+}
+`, rName)
+}
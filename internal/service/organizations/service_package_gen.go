@@ -126,6 +126,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_organizations_policy_attachment",
 			Name:     "Policy Attachment",
 		},
+		{
+			Factory:  resourcePolicyAttachmentsExclusive,
+			TypeName: "aws_organizations_policy_attachments_exclusive",
+			Name:     "Policy Attachments Exclusive",
+		},
 		{
 			Factory:  resourceResourcePolicy,
 			TypeName: "aws_organizations_resource_policy",
@@ -9,7 +9,11 @@ var (
 	ResourceEmailChannel  = resourceEmailChannel
 	ResourceEmailTemplate = newResourceEmailTemplate
 	ResourceEventStream   = resourceEventStream
+	ResourceJourney       = resourceJourney
+	ResourcePushTemplate  = newResourcePushTemplate
+	ResourceSegment       = resourceSegment
 	ResourceSMSChannel    = resourceSMSChannel
+	ResourceSMSTemplate   = newResourceSMSTemplate
 
 	FindADMChannelByApplicationId             = findADMChannelByApplicationId
 	FindAPNSChannelByApplicationId            = findAPNSChannelByApplicationId
@@ -23,6 +27,10 @@ var (
 	FindGCMChannelByApplicationId             = findGCMChannelByApplicationId
 	FindSMSChannelByApplicationId             = findSMSChannelByApplicationId
 	FindEmailTemplateByName                   = findEmailTemplateByName
+	FindJourneyByTwoPartKey                   = findJourneyByTwoPartKey
+	FindPushTemplateByName                    = findPushTemplateByName
+	FindSegmentByTwoPartKey                   = findSegmentByTwoPartKey
+	FindSMSTemplateByName                     = findSMSTemplateByName
 )
 
 const (
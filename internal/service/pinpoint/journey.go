@@ -0,0 +1,553 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pinpoint
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/pinpoint"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pinpoint/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_pinpoint_journey", name="Journey")
+// @Tags(identifierAttribute="arn")
+func resourceJourney() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceJourneyCreate,
+		ReadWithoutTimeout:   resourceJourneyRead,
+		UpdateWithoutTimeout: resourceJourneyUpdate,
+		DeleteWithoutTimeout: resourceJourneyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"activities": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentJSONDocumentDiffs[map[string]awstypes.Activity],
+			},
+			names.AttrApplicationID: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"journey_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"limits": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"daily_cap": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"endpoint_reentry_cap": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"endpoint_reentry_interval": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"messages_per_second": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+			"local_time": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"quiet_time": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"end": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"start": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"refresh_frequency": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"refresh_on_segment_update": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			names.AttrSchedule: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"end_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+						},
+						names.AttrStartTime: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+						},
+						"timezone": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"start_activity": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"start_condition": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentJSONDocumentDiffs[awstypes.StartCondition],
+			},
+			names.AttrState: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(enum.Slice(awstypes.StateDraft, awstypes.StateActive), false),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"wait_for_quiet_time": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	journeyResourceIDPartCount = 2
+)
+
+func resourceJourneyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PinpointClient(ctx)
+
+	request, err := expandWriteJourneyRequest(d)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	applicationID, name := d.Get(names.AttrApplicationID).(string), d.Get(names.AttrName).(string)
+	input := &pinpoint.CreateJourneyInput{
+		ApplicationId:       aws.String(applicationID),
+		WriteJourneyRequest: request,
+	}
+
+	output, err := conn.CreateJourney(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Pinpoint Journey (%s): %s", name, err)
+	}
+
+	id, err := flex.FlattenResourceId([]string{applicationID, aws.ToString(output.JourneyResponse.Id)}, journeyResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	// WriteJourneyRequest does not accept tags.
+	if tags := getTagsIn(ctx); len(tags) > 0 {
+		if err := createTags(ctx, conn, journeyARN(meta.(*conns.AWSClient), applicationID, aws.ToString(output.JourneyResponse.Id)), tags); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting Pinpoint Journey (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceJourneyRead(ctx, d, meta)...)
+}
+
+func resourceJourneyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PinpointClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), journeyResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	journey, err := findJourneyByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Pinpoint Journey (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Pinpoint Journey (%s): %s", d.Id(), err)
+	}
+
+	if len(journey.Activities) > 0 {
+		v, err := flattenJSONDocument(journey.Activities)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		d.Set("activities", v)
+	} else {
+		d.Set("activities", nil)
+	}
+	d.Set(names.AttrApplicationID, journey.ApplicationId)
+	d.Set(names.AttrARN, journeyARN(meta.(*conns.AWSClient), aws.ToString(journey.ApplicationId), aws.ToString(journey.Id)))
+	d.Set("journey_id", journey.Id)
+	if err := d.Set("limits", flattenJourneyLimits(journey.Limits)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting limits: %s", err)
+	}
+	d.Set("local_time", journey.LocalTime)
+	d.Set(names.AttrName, journey.Name)
+	if err := d.Set("quiet_time", flattenJourneyQuietTime(journey.QuietTime)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting quiet_time: %s", err)
+	}
+	d.Set("refresh_frequency", journey.RefreshFrequency)
+	d.Set("refresh_on_segment_update", journey.RefreshOnSegmentUpdate)
+	if err := d.Set(names.AttrSchedule, flattenJourneySchedule(journey.Schedule)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting schedule: %s", err)
+	}
+	d.Set("start_activity", journey.StartActivity)
+	if journey.StartCondition != nil {
+		v, err := flattenJSONDocument(journey.StartCondition)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		d.Set("start_condition", v)
+	} else {
+		d.Set("start_condition", nil)
+	}
+	d.Set(names.AttrState, journey.State)
+	d.Set("wait_for_quiet_time", journey.WaitForQuietTime)
+
+	return diags
+}
+
+func resourceJourneyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PinpointClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		parts, err := flex.ExpandResourceId(d.Id(), journeyResourceIDPartCount, false)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		request, err := expandWriteJourneyRequest(d)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input := &pinpoint.UpdateJourneyInput{
+			ApplicationId:       aws.String(parts[0]),
+			JourneyId:           aws.String(parts[1]),
+			WriteJourneyRequest: request,
+		}
+
+		_, err = conn.UpdateJourney(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Pinpoint Journey (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceJourneyRead(ctx, d, meta)...)
+}
+
+func resourceJourneyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PinpointClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), journeyResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[INFO] Deleting Pinpoint Journey: %s", d.Id())
+	_, err = conn.DeleteJourney(ctx, &pinpoint.DeleteJourneyInput{
+		ApplicationId: aws.String(parts[0]),
+		JourneyId:     aws.String(parts[1]),
+	})
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Pinpoint Journey (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findJourneyByTwoPartKey(ctx context.Context, conn *pinpoint.Client, applicationID, journeyID string) (*awstypes.JourneyResponse, error) {
+	input := &pinpoint.GetJourneyInput{
+		ApplicationId: aws.String(applicationID),
+		JourneyId:     aws.String(journeyID),
+	}
+
+	output, err := conn.GetJourney(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.JourneyResponse == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.JourneyResponse, nil
+}
+
+// journeyARN returns the ARN of the specified journey. The journey API doesn't return it.
+func journeyARN(c *conns.AWSClient, applicationID, journeyID string) string {
+	return arn.ARN{
+		Partition: c.Partition,
+		Service:   "mobiletargeting",
+		Region:    c.Region,
+		AccountID: c.AccountID,
+		Resource:  fmt.Sprintf("apps/%s/journeys/%s", applicationID, journeyID),
+	}.String()
+}
+
+func expandWriteJourneyRequest(d *schema.ResourceData) (*awstypes.WriteJourneyRequest, error) {
+	apiObject := &awstypes.WriteJourneyRequest{
+		LocalTime:              aws.Bool(d.Get("local_time").(bool)),
+		Name:                   aws.String(d.Get(names.AttrName).(string)),
+		RefreshOnSegmentUpdate: aws.Bool(d.Get("refresh_on_segment_update").(bool)),
+		WaitForQuietTime:       aws.Bool(d.Get("wait_for_quiet_time").(bool)),
+	}
+
+	if v, ok := d.GetOk("activities"); ok {
+		activities, err := expandJSONDocument[map[string]awstypes.Activity](v.(string))
+
+		if err != nil {
+			return nil, fmt.Errorf("decoding activities: %w", err)
+		}
+
+		apiObject.Activities = *activities
+	}
+
+	if v, ok := d.GetOk("limits"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.Limits = expandJourneyLimits(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("quiet_time"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.QuietTime = expandJourneyQuietTime(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("refresh_frequency"); ok {
+		apiObject.RefreshFrequency = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrSchedule); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.Schedule = expandJourneySchedule(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("start_activity"); ok {
+		apiObject.StartActivity = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("start_condition"); ok {
+		startCondition, err := expandJSONDocument[awstypes.StartCondition](v.(string))
+
+		if err != nil {
+			return nil, fmt.Errorf("decoding start_condition: %w", err)
+		}
+
+		apiObject.StartCondition = startCondition
+	}
+
+	if v, ok := d.GetOk(names.AttrState); ok {
+		apiObject.State = awstypes.State(v.(string))
+	}
+
+	return apiObject, nil
+}
+
+func expandJourneyLimits(tfMap map[string]interface{}) *awstypes.JourneyLimits {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.JourneyLimits{}
+
+	if v, ok := tfMap["daily_cap"].(int); ok && v != 0 {
+		apiObject.DailyCap = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["endpoint_reentry_cap"].(int); ok && v != 0 {
+		apiObject.EndpointReentryCap = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["endpoint_reentry_interval"].(string); ok && v != "" {
+		apiObject.EndpointReentryInterval = aws.String(v)
+	}
+
+	if v, ok := tfMap["messages_per_second"].(int); ok && v != 0 {
+		apiObject.MessagesPerSecond = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func flattenJourneyLimits(apiObject *awstypes.JourneyLimits) []interface{} {
+	if apiObject == nil || (apiObject.DailyCap == nil && apiObject.EndpointReentryCap == nil && apiObject.EndpointReentryInterval == nil && apiObject.MessagesPerSecond == nil) {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"daily_cap":                 aws.ToInt32(apiObject.DailyCap),
+		"endpoint_reentry_cap":      aws.ToInt32(apiObject.EndpointReentryCap),
+		"endpoint_reentry_interval": aws.ToString(apiObject.EndpointReentryInterval),
+		"messages_per_second":       aws.ToInt32(apiObject.MessagesPerSecond),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandJourneyQuietTime(tfMap map[string]interface{}) *awstypes.QuietTime {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.QuietTime{}
+
+	if v, ok := tfMap["end"].(string); ok && v != "" {
+		apiObject.End = aws.String(v)
+	}
+
+	if v, ok := tfMap["start"].(string); ok && v != "" {
+		apiObject.Start = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenJourneyQuietTime(apiObject *awstypes.QuietTime) []interface{} {
+	if apiObject == nil || (apiObject.End == nil && apiObject.Start == nil) {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"end":   aws.ToString(apiObject.End),
+		"start": aws.ToString(apiObject.Start),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandJourneySchedule(tfMap map[string]interface{}) *awstypes.JourneySchedule {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.JourneySchedule{}
+
+	if v, ok := tfMap["end_time"].(string); ok && v != "" {
+		t, _ := time.Parse(time.RFC3339, v)
+		apiObject.EndTime = aws.Time(t)
+	}
+
+	if v, ok := tfMap[names.AttrStartTime].(string); ok && v != "" {
+		t, _ := time.Parse(time.RFC3339, v)
+		apiObject.StartTime = aws.Time(t)
+	}
+
+	if v, ok := tfMap["timezone"].(string); ok && v != "" {
+		apiObject.Timezone = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenJourneySchedule(apiObject *awstypes.JourneySchedule) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"timezone": aws.ToString(apiObject.Timezone),
+	}
+
+	if v := apiObject.EndTime; v != nil {
+		tfMap["end_time"] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.StartTime; v != nil {
+		tfMap[names.AttrStartTime] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,256 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pinpoint_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pinpoint/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpinpoint "github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccPinpointJourney_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var journey awstypes.JourneyResponse
+	resourceName := "aws_pinpoint_journey.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckApp(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PinpointServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJourneyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJourneyConfig_basic(rName, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJourneyExists(ctx, resourceName, &journey),
+					resource.TestCheckResourceAttrSet(resourceName, "activities"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrApplicationID, "aws_pinpoint_app.test", names.AttrApplicationID),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "mobiletargeting", regexache.MustCompile(`apps/.+/journeys/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "journey_id"),
+					resource.TestCheckResourceAttr(resourceName, "limits.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "limits.0.daily_cap", "10"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "start_activity", "wait"),
+					resource.TestCheckResourceAttrSet(resourceName, "start_condition"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, string(awstypes.StateDraft)),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccJourneyConfig_basic(rName, 7200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJourneyExists(ctx, resourceName, &journey),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, string(awstypes.StateDraft)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPinpointJourney_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var journey awstypes.JourneyResponse
+	resourceName := "aws_pinpoint_journey.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckApp(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PinpointServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJourneyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJourneyConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJourneyExists(ctx, resourceName, &journey),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccJourneyConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJourneyExists(ctx, resourceName, &journey),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPinpointJourney_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var journey awstypes.JourneyResponse
+	resourceName := "aws_pinpoint_journey.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckApp(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PinpointServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJourneyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJourneyConfig_basic(rName, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJourneyExists(ctx, resourceName, &journey),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfpinpoint.ResourceJourney(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckJourneyExists(ctx context.Context, n string, v *awstypes.JourneyResponse) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointClient(ctx)
+
+		output, err := tfpinpoint.FindJourneyByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrApplicationID], rs.Primary.Attributes["journey_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckJourneyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_pinpoint_journey" {
+				continue
+			}
+
+			_, err := tfpinpoint.FindJourneyByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrApplicationID], rs.Primary.Attributes["journey_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Pinpoint Journey %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccJourneyConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_app" "test" {
+  name = %[1]q
+}
+
+resource "aws_pinpoint_segment" "test" {
+  application_id = aws_pinpoint_app.test.application_id
+  name           = %[1]q
+
+  dimensions = jsonencode({
+    Demographic = {
+      Platform = {
+        DimensionType = "INCLUSIVE"
+        Values        = ["iOS"]
+      }
+    }
+  })
+}
+`, rName)
+}
+
+func testAccJourneyConfig_basic(rName string, waitSeconds int) string {
+	return acctest.ConfigCompose(testAccJourneyConfig_base(rName), fmt.Sprintf(`
+resource "aws_pinpoint_journey" "test" {
+  application_id = aws_pinpoint_app.test.application_id
+  name           = %[1]q
+  start_activity = "wait"
+
+  activities = jsonencode({
+    wait = {
+      Wait = {
+        WaitTime = {
+          WaitFor = "PT%[2]dS"
+        }
+      }
+    }
+  })
+
+  start_condition = jsonencode({
+    SegmentStartCondition = {
+      SegmentId = aws_pinpoint_segment.test.segment_id
+    }
+  })
+
+  limits {
+    daily_cap = 10
+  }
+}
+`, rName, waitSeconds))
+}
+
+func testAccJourneyConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccJourneyConfig_base(rName), fmt.Sprintf(`
+resource "aws_pinpoint_journey" "test" {
+  application_id = aws_pinpoint_app.test.application_id
+  name           = %[1]q
+  start_activity = "wait"
+
+  activities = jsonencode({
+    wait = {
+      Wait = {
+        WaitTime = {
+          WaitFor = "PT3600S"
+        }
+      }
+    }
+  })
+
+  start_condition = jsonencode({
+    SegmentStartCondition = {
+      SegmentId = aws_pinpoint_segment.test.segment_id
+    }
+  })
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
@@ -0,0 +1,405 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pinpoint
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pinpoint"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pinpoint/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_pinpoint_push_template", name="Push Template")
+// @Tags(identifierAttribute="arn")
+func newResourcePushTemplate(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourcePushTemplate{}, nil
+}
+
+const (
+	ResNamePushTemplate = "Push Template"
+)
+
+type resourcePushTemplate struct {
+	framework.ResourceWithConfigure
+}
+
+func (*resourcePushTemplate) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_pinpoint_push_template"
+}
+
+func (r *resourcePushTemplate) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"template_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"push_notification_template": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[pushNotificationTemplate](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"default_substitutions": schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrDescription: schema.StringAttribute{
+							Optional: true,
+						},
+						"recommender_id": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"adm":     androidPushNotificationTemplateBlock(ctx),
+						"apns":    apnsPushNotificationTemplateBlock(ctx),
+						"baidu":   androidPushNotificationTemplateBlock(ctx),
+						"default": defaultPushNotificationTemplateBlock(ctx),
+						"gcm":     androidPushNotificationTemplateBlock(ctx),
+					},
+				},
+			},
+		},
+	}
+}
+
+func androidPushNotificationTemplateBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[androidPushNotificationTemplate](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrAction: schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.Action](),
+					Optional:   true,
+				},
+				"body": schema.StringAttribute{
+					Optional: true,
+				},
+				"image_icon_url": schema.StringAttribute{
+					Optional: true,
+				},
+				"image_url": schema.StringAttribute{
+					Optional: true,
+				},
+				"raw_content": schema.StringAttribute{
+					Optional: true,
+				},
+				"small_image_icon_url": schema.StringAttribute{
+					Optional: true,
+				},
+				"sound": schema.StringAttribute{
+					Optional: true,
+				},
+				"title": schema.StringAttribute{
+					Optional: true,
+				},
+				names.AttrURL: schema.StringAttribute{
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func apnsPushNotificationTemplateBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[apnsPushNotificationTemplate](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrAction: schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.Action](),
+					Optional:   true,
+				},
+				"body": schema.StringAttribute{
+					Optional: true,
+				},
+				"media_url": schema.StringAttribute{
+					Optional: true,
+				},
+				"raw_content": schema.StringAttribute{
+					Optional: true,
+				},
+				"sound": schema.StringAttribute{
+					Optional: true,
+				},
+				"title": schema.StringAttribute{
+					Optional: true,
+				},
+				names.AttrURL: schema.StringAttribute{
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func defaultPushNotificationTemplateBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[defaultPushNotificationTemplate](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrAction: schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.Action](),
+					Optional:   true,
+				},
+				"body": schema.StringAttribute{
+					Optional: true,
+				},
+				"sound": schema.StringAttribute{
+					Optional: true,
+				},
+				"title": schema.StringAttribute{
+					Optional: true,
+				},
+				names.AttrURL: schema.StringAttribute{
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func (r *resourcePushTemplate) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().PinpointClient(ctx)
+
+	var plan pushTemplateData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &pinpoint.CreatePushTemplateInput{}
+	resp.Diagnostics.Append(flex.Expand(ctx, &plan, in, flex.WithFieldNameSuffix("Request"))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in.PushNotificationTemplateRequest.Tags = getTagsIn(ctx)
+
+	out, err := conn.CreatePushTemplate(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Pinpoint, create.ErrActionCreating, ResNamePushTemplate, plan.TemplateName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+	if out == nil || out.CreateTemplateMessageBody == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Pinpoint, create.ErrActionCreating, ResNamePushTemplate, plan.TemplateName.String(), nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	plan.Arn = flex.StringToFramework(ctx, out.CreateTemplateMessageBody.Arn)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourcePushTemplate) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().PinpointClient(ctx)
+
+	var state pushTemplateData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findPushTemplateByName(ctx, conn, state.TemplateName.ValueString())
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Pinpoint, create.ErrActionSetting, ResNamePushTemplate, state.TemplateName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, out, &state, flex.WithFieldNameSuffix("Response"))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Arn = flex.StringToFramework(ctx, out.PushNotificationTemplateResponse.Arn)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourcePushTemplate) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().PinpointClient(ctx)
+
+	var plan, state pushTemplateData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.PushNotificationTemplate.Equal(state.PushNotificationTemplate) {
+		in := &pinpoint.UpdatePushTemplateInput{}
+		resp.Diagnostics.Append(flex.Expand(ctx, &plan, in, flex.WithFieldNameSuffix("Request"))...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		in.TemplateName = plan.TemplateName.ValueStringPointer()
+
+		_, err := conn.UpdatePushTemplate(ctx, in)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.Pinpoint, create.ErrActionUpdating, ResNamePushTemplate, plan.TemplateName.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	plan.Arn = state.Arn
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourcePushTemplate) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().PinpointClient(ctx)
+
+	var state pushTemplateData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.DeletePushTemplate(ctx, &pinpoint.DeletePushTemplateInput{
+		TemplateName: state.TemplateName.ValueStringPointer(),
+	})
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Pinpoint, create.ErrActionDeleting, ResNamePushTemplate, state.TemplateName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourcePushTemplate) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("template_name"), request, response)
+}
+
+func (r *resourcePushTemplate) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findPushTemplateByName(ctx context.Context, conn *pinpoint.Client, name string) (*pinpoint.GetPushTemplateOutput, error) {
+	in := &pinpoint.GetPushTemplateInput{
+		TemplateName: aws.String(name),
+	}
+
+	out, err := conn.GetPushTemplate(ctx, in)
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.PushNotificationTemplateResponse == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+type pushTemplateData struct {
+	Arn                      types.String                                              `tfsdk:"arn"`
+	PushNotificationTemplate fwtypes.ListNestedObjectValueOf[pushNotificationTemplate] `tfsdk:"push_notification_template"`
+	Tags                     tftags.Map                                                `tfsdk:"tags"`
+	TagsAll                  tftags.Map                                                `tfsdk:"tags_all"`
+	TemplateName             types.String                                              `tfsdk:"template_name"`
+}
+
+type pushNotificationTemplate struct {
+	ADM                  fwtypes.ListNestedObjectValueOf[androidPushNotificationTemplate] `tfsdk:"adm"`
+	APNS                 fwtypes.ListNestedObjectValueOf[apnsPushNotificationTemplate]    `tfsdk:"apns"`
+	Baidu                fwtypes.ListNestedObjectValueOf[androidPushNotificationTemplate] `tfsdk:"baidu"`
+	Default              fwtypes.ListNestedObjectValueOf[defaultPushNotificationTemplate] `tfsdk:"default"`
+	DefaultSubstitutions types.String                                                     `tfsdk:"default_substitutions"`
+	GCM                  fwtypes.ListNestedObjectValueOf[androidPushNotificationTemplate] `tfsdk:"gcm"`
+	RecommenderId        types.String                                                     `tfsdk:"recommender_id"`
+	TemplateDescription  types.String                                                     `tfsdk:"description"`
+}
+
+type androidPushNotificationTemplate struct {
+	Action            fwtypes.StringEnum[awstypes.Action] `tfsdk:"action"`
+	Body              types.String                        `tfsdk:"body"`
+	ImageIconUrl      types.String                        `tfsdk:"image_icon_url"`
+	ImageUrl          types.String                        `tfsdk:"image_url"`
+	RawContent        types.String                        `tfsdk:"raw_content"`
+	SmallImageIconUrl types.String                        `tfsdk:"small_image_icon_url"`
+	Sound             types.String                        `tfsdk:"sound"`
+	Title             types.String                        `tfsdk:"title"`
+	Url               types.String                        `tfsdk:"url"`
+}
+
+type apnsPushNotificationTemplate struct {
+	Action     fwtypes.StringEnum[awstypes.Action] `tfsdk:"action"`
+	Body       types.String                        `tfsdk:"body"`
+	MediaUrl   types.String                        `tfsdk:"media_url"`
+	RawContent types.String                        `tfsdk:"raw_content"`
+	Sound      types.String                        `tfsdk:"sound"`
+	Title      types.String                        `tfsdk:"title"`
+	Url        types.String                        `tfsdk:"url"`
+}
+
+type defaultPushNotificationTemplate struct {
+	Action fwtypes.StringEnum[awstypes.Action] `tfsdk:"action"`
+	Body   types.String                        `tfsdk:"body"`
+	Sound  types.String                        `tfsdk:"sound"`
+	Title  types.String                        `tfsdk:"title"`
+	Url    types.String                        `tfsdk:"url"`
+}
@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pinpoint_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/pinpoint"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpinpoint "github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccPinpointPushTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_pinpoint_push_template.test"
+	var template pinpoint.GetPushTemplateOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PinpointServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPushTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPushTemplateConfig_basic(rName, "Hello"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPushTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "template_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "push_notification_template.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "push_notification_template.0.default.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "push_notification_template.0.default.0.action", "OPEN_APP"),
+					resource.TestCheckResourceAttr(resourceName, "push_notification_template.0.default.0.body", "Hello"),
+					resource.TestCheckResourceAttr(resourceName, "push_notification_template.0.gcm.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "push_notification_template.0.gcm.0.title", "Android"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccTemplateImportStateIDFunc(resourceName),
+				ImportStateVerifyIdentifierAttribute: "template_name",
+				ImportStateVerify:                    true,
+			},
+			{
+				Config: testAccPushTemplateConfig_basic(rName, "Goodbye"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPushTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "push_notification_template.0.default.0.body", "Goodbye"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPinpointPushTemplate_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_pinpoint_push_template.test"
+	var template pinpoint.GetPushTemplateOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PinpointServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPushTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPushTemplateConfig_basic(rName, "Hello"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPushTemplateExists(ctx, resourceName, &template),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfpinpoint.ResourcePushTemplate, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPushTemplateExists(ctx context.Context, n string, v *pinpoint.GetPushTemplateOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointClient(ctx)
+
+		output, err := tfpinpoint.FindPushTemplateByName(ctx, conn, rs.Primary.Attributes["template_name"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckPushTemplateDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_pinpoint_push_template" {
+				continue
+			}
+
+			_, err := tfpinpoint.FindPushTemplateByName(ctx, conn, rs.Primary.Attributes["template_name"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Pinpoint Push Template %s still exists", rs.Primary.Attributes["template_name"])
+		}
+
+		return nil
+	}
+}
+
+func testAccPushTemplateConfig_basic(rName, body string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_push_template" "test" {
+  template_name = %[1]q
+
+  push_notification_template {
+    description = "testing"
+
+    default {
+      action = "OPEN_APP"
+      body   = %[2]q
+      title  = "Default"
+    }
+
+    gcm {
+      action = "OPEN_APP"
+      body   = %[2]q
+      title  = "Android"
+    }
+  }
+}
+`, rName, body)
+}
@@ -0,0 +1,344 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pinpoint
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pinpoint"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pinpoint/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_pinpoint_segment", name="Segment")
+// @Tags(identifierAttribute="arn")
+func resourceSegment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSegmentCreate,
+		ReadWithoutTimeout:   resourceSegmentRead,
+		UpdateWithoutTimeout: resourceSegmentUpdate,
+		DeleteWithoutTimeout: resourceSegmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrApplicationID: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dimensions": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentJSONDocumentDiffs[awstypes.SegmentDimensions],
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"segment_groups": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentJSONDocumentDiffs[awstypes.SegmentGroupList],
+			},
+			"segment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"segment_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			names.AttrVersion: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	segmentResourceIDPartCount = 2
+)
+
+func resourceSegmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PinpointClient(ctx)
+
+	request, err := expandWriteSegmentRequest(d)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	request.Tags = getTagsIn(ctx)
+
+	applicationID, name := d.Get(names.AttrApplicationID).(string), d.Get(names.AttrName).(string)
+	input := &pinpoint.CreateSegmentInput{
+		ApplicationId:       aws.String(applicationID),
+		WriteSegmentRequest: request,
+	}
+
+	output, err := conn.CreateSegment(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Pinpoint Segment (%s): %s", name, err)
+	}
+
+	id, err := flex.FlattenResourceId([]string{applicationID, aws.ToString(output.SegmentResponse.Id)}, segmentResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceSegmentRead(ctx, d, meta)...)
+}
+
+func resourceSegmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PinpointClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), segmentResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	applicationID, segmentID := parts[0], parts[1]
+	segment, err := findSegmentByTwoPartKey(ctx, conn, applicationID, segmentID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Pinpoint Segment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Pinpoint Segment (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrApplicationID, segment.ApplicationId)
+	d.Set(names.AttrARN, segment.Arn)
+	if segment.Dimensions != nil {
+		v, err := flattenJSONDocument(segment.Dimensions)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		d.Set("dimensions", v)
+	} else {
+		d.Set("dimensions", nil)
+	}
+	d.Set(names.AttrName, segment.Name)
+	if segment.SegmentGroups != nil {
+		v, err := flattenJSONDocument(segment.SegmentGroups)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		d.Set("segment_groups", v)
+	} else {
+		d.Set("segment_groups", nil)
+	}
+	d.Set("segment_id", segment.Id)
+	d.Set("segment_type", segment.SegmentType)
+	d.Set(names.AttrVersion, segment.Version)
+
+	return diags
+}
+
+func resourceSegmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PinpointClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		parts, err := flex.ExpandResourceId(d.Id(), segmentResourceIDPartCount, false)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		request, err := expandWriteSegmentRequest(d)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input := &pinpoint.UpdateSegmentInput{
+			ApplicationId:       aws.String(parts[0]),
+			SegmentId:           aws.String(parts[1]),
+			WriteSegmentRequest: request,
+		}
+
+		_, err = conn.UpdateSegment(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Pinpoint Segment (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceSegmentRead(ctx, d, meta)...)
+}
+
+func resourceSegmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PinpointClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), segmentResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[INFO] Deleting Pinpoint Segment: %s", d.Id())
+	_, err = conn.DeleteSegment(ctx, &pinpoint.DeleteSegmentInput{
+		ApplicationId: aws.String(parts[0]),
+		SegmentId:     aws.String(parts[1]),
+	})
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Pinpoint Segment (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findSegmentByTwoPartKey(ctx context.Context, conn *pinpoint.Client, applicationID, segmentID string) (*awstypes.SegmentResponse, error) {
+	input := &pinpoint.GetSegmentInput{
+		ApplicationId: aws.String(applicationID),
+		SegmentId:     aws.String(segmentID),
+	}
+
+	output, err := conn.GetSegment(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.SegmentResponse == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.SegmentResponse, nil
+}
+
+func expandWriteSegmentRequest(d *schema.ResourceData) (*awstypes.WriteSegmentRequest, error) {
+	apiObject := &awstypes.WriteSegmentRequest{
+		Name: aws.String(d.Get(names.AttrName).(string)),
+	}
+
+	if v, ok := d.GetOk("dimensions"); ok {
+		dimensions, err := expandJSONDocument[awstypes.SegmentDimensions](v.(string))
+
+		if err != nil {
+			return nil, fmt.Errorf("decoding dimensions: %w", err)
+		}
+
+		apiObject.Dimensions = dimensions
+	}
+
+	if v, ok := d.GetOk("segment_groups"); ok {
+		segmentGroups, err := expandJSONDocument[awstypes.SegmentGroupList](v.(string))
+
+		if err != nil {
+			return nil, fmt.Errorf("decoding segment_groups: %w", err)
+		}
+
+		apiObject.SegmentGroups = segmentGroups
+	}
+
+	return apiObject, nil
+}
+
+// expandJSONDocument decodes a JSON document into the corresponding API structure.
+// Member names are matched case-insensitively, so both the API's camelCase
+// representation and the SDK's field names are accepted.
+func expandJSONDocument[T any](s string) (*T, error) {
+	var apiObject T
+
+	if err := tfjson.DecodeFromString(s, &apiObject); err != nil {
+		return nil, err
+	}
+
+	return &apiObject, nil
+}
+
+func flattenJSONDocument(apiObject any) (string, error) {
+	b, err := json.Marshal(apiObject)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(tfjson.RemoveEmptyFields(b)), nil
+}
+
+// suppressEquivalentJSONDocumentDiffs suppresses differences between JSON documents
+// that decode to the same API structure.
+func suppressEquivalentJSONDocumentDiffs[T any](k, old, new string, d *schema.ResourceData) bool {
+	normalize := func(s string) (string, error) {
+		apiObject, err := expandJSONDocument[T](s)
+
+		if err != nil {
+			return "", err
+		}
+
+		return flattenJSONDocument(apiObject)
+	}
+
+	oldDocument, err := normalize(old)
+	if err != nil {
+		return false
+	}
+
+	newDocument, err := normalize(new)
+	if err != nil {
+		return false
+	}
+
+	return tfjson.EqualStrings(oldDocument, newDocument)
+}
@@ -0,0 +1,243 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pinpoint_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pinpoint/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpinpoint "github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccPinpointSegment_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var segment awstypes.SegmentResponse
+	resourceName := "aws_pinpoint_segment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckApp(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PinpointServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSegmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSegmentConfig_basic(rName, "iOS"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentExists(ctx, resourceName, &segment),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrApplicationID, "aws_pinpoint_app.test", names.AttrApplicationID),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "mobiletargeting", regexache.MustCompile(`apps/.+/segments/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "dimensions"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrSet(resourceName, "segment_id"),
+					resource.TestCheckResourceAttr(resourceName, "segment_type", "DIMENSIONAL"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSegmentConfig_basic(rName, "Android"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentExists(ctx, resourceName, &segment),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPinpointSegment_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var segment awstypes.SegmentResponse
+	resourceName := "aws_pinpoint_segment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckApp(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PinpointServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSegmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSegmentConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentExists(ctx, resourceName, &segment),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSegmentConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentExists(ctx, resourceName, &segment),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPinpointSegment_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var segment awstypes.SegmentResponse
+	resourceName := "aws_pinpoint_segment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckApp(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PinpointServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSegmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSegmentConfig_basic(rName, "iOS"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentExists(ctx, resourceName, &segment),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfpinpoint.ResourceSegment(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSegmentExists(ctx context.Context, n string, v *awstypes.SegmentResponse) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointClient(ctx)
+
+		output, err := tfpinpoint.FindSegmentByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrApplicationID], rs.Primary.Attributes["segment_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckSegmentDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_pinpoint_segment" {
+				continue
+			}
+
+			_, err := tfpinpoint.FindSegmentByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrApplicationID], rs.Primary.Attributes["segment_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Pinpoint Segment %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccSegmentConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_app" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccSegmentConfig_basic(rName, platform string) string {
+	return acctest.ConfigCompose(testAccSegmentConfig_base(rName), fmt.Sprintf(`
+resource "aws_pinpoint_segment" "test" {
+  application_id = aws_pinpoint_app.test.application_id
+  name           = %[1]q
+
+  dimensions = jsonencode({
+    Demographic = {
+      Platform = {
+        DimensionType = "INCLUSIVE"
+        Values        = [%[2]q]
+      }
+    }
+  })
+}
+`, rName, platform))
+}
+
+func testAccSegmentConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccSegmentConfig_base(rName), fmt.Sprintf(`
+resource "aws_pinpoint_segment" "test" {
+  application_id = aws_pinpoint_app.test.application_id
+  name           = %[1]q
+
+  dimensions = jsonencode({
+    Demographic = {
+      Platform = {
+        DimensionType = "INCLUSIVE"
+        Values        = ["iOS"]
+      }
+    }
+  })
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccSegmentConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccSegmentConfig_base(rName), fmt.Sprintf(`
+resource "aws_pinpoint_segment" "test" {
+  application_id = aws_pinpoint_app.test.application_id
+  name           = %[1]q
+
+  dimensions = jsonencode({
+    Demographic = {
+      Platform = {
+        DimensionType = "INCLUSIVE"
+        Values        = ["iOS"]
+      }
+    }
+  })
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -27,6 +27,20 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory: newResourcePushTemplate,
+			Name:    "Push Template",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory: newResourceSMSTemplate,
+			Name:    "SMS Template",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 	}
 }
 
@@ -89,6 +103,22 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_pinpoint_gcm_channel",
 			Name:     "GCM Channel",
 		},
+		{
+			Factory:  resourceJourney,
+			TypeName: "aws_pinpoint_journey",
+			Name:     "Journey",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  resourceSegment,
+			TypeName: "aws_pinpoint_segment",
+			Name:     "Segment",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  resourceSMSChannel,
 			TypeName: "aws_pinpoint_sms_channel",
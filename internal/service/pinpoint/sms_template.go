@@ -0,0 +1,262 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pinpoint
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pinpoint"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pinpoint/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_pinpoint_sms_template", name="SMS Template")
+// @Tags(identifierAttribute="arn")
+func newResourceSMSTemplate(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceSMSTemplate{}, nil
+}
+
+const (
+	ResNameSMSTemplate = "SMS Template"
+)
+
+type resourceSMSTemplate struct {
+	framework.ResourceWithConfigure
+}
+
+func (*resourceSMSTemplate) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_pinpoint_sms_template"
+}
+
+func (r *resourceSMSTemplate) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"template_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"sms_template": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[smsTemplate](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"body": schema.StringAttribute{
+							Optional: true,
+						},
+						"default_substitutions": schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrDescription: schema.StringAttribute{
+							Optional: true,
+						},
+						"recommender_id": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceSMSTemplate) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().PinpointClient(ctx)
+
+	var plan smsTemplateData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &pinpoint.CreateSmsTemplateInput{}
+	resp.Diagnostics.Append(flex.Expand(ctx, &plan, in, flex.WithFieldNameSuffix("Request"))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in.SMSTemplateRequest.Tags = getTagsIn(ctx)
+
+	out, err := conn.CreateSmsTemplate(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Pinpoint, create.ErrActionCreating, ResNameSMSTemplate, plan.TemplateName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+	if out == nil || out.CreateTemplateMessageBody == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Pinpoint, create.ErrActionCreating, ResNameSMSTemplate, plan.TemplateName.String(), nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	plan.Arn = flex.StringToFramework(ctx, out.CreateTemplateMessageBody.Arn)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceSMSTemplate) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().PinpointClient(ctx)
+
+	var state smsTemplateData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findSMSTemplateByName(ctx, conn, state.TemplateName.ValueString())
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Pinpoint, create.ErrActionSetting, ResNameSMSTemplate, state.TemplateName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, out, &state, flex.WithFieldNameSuffix("Response"))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Arn = flex.StringToFramework(ctx, out.SMSTemplateResponse.Arn)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceSMSTemplate) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().PinpointClient(ctx)
+
+	var plan, state smsTemplateData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.SMSTemplate.Equal(state.SMSTemplate) {
+		in := &pinpoint.UpdateSmsTemplateInput{}
+		resp.Diagnostics.Append(flex.Expand(ctx, &plan, in, flex.WithFieldNameSuffix("Request"))...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		in.TemplateName = plan.TemplateName.ValueStringPointer()
+
+		_, err := conn.UpdateSmsTemplate(ctx, in)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.Pinpoint, create.ErrActionUpdating, ResNameSMSTemplate, plan.TemplateName.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	plan.Arn = state.Arn
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceSMSTemplate) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().PinpointClient(ctx)
+
+	var state smsTemplateData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.DeleteSmsTemplate(ctx, &pinpoint.DeleteSmsTemplateInput{
+		TemplateName: state.TemplateName.ValueStringPointer(),
+	})
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Pinpoint, create.ErrActionDeleting, ResNameSMSTemplate, state.TemplateName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceSMSTemplate) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("template_name"), request, response)
+}
+
+func (r *resourceSMSTemplate) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findSMSTemplateByName(ctx context.Context, conn *pinpoint.Client, name string) (*pinpoint.GetSmsTemplateOutput, error) {
+	in := &pinpoint.GetSmsTemplateInput{
+		TemplateName: aws.String(name),
+	}
+
+	out, err := conn.GetSmsTemplate(ctx, in)
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.SMSTemplateResponse == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+type smsTemplateData struct {
+	Arn          types.String                                 `tfsdk:"arn"`
+	SMSTemplate  fwtypes.ListNestedObjectValueOf[smsTemplate] `tfsdk:"sms_template"`
+	Tags         tftags.Map                                   `tfsdk:"tags"`
+	TagsAll      tftags.Map                                   `tfsdk:"tags_all"`
+	TemplateName types.String                                 `tfsdk:"template_name"`
+}
+
+type smsTemplate struct {
+	Body                 types.String `tfsdk:"body"`
+	DefaultSubstitutions types.String `tfsdk:"default_substitutions"`
+	RecommenderId        types.String `tfsdk:"recommender_id"`
+	TemplateDescription  types.String `tfsdk:"description"`
+}
@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pinpoint_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/pinpoint"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpinpoint "github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccPinpointSMSTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_pinpoint_sms_template.test"
+	var template pinpoint.GetSmsTemplateOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PinpointServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSMSTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSMSTemplateConfig_basic(rName, "Hello {{User.UserAttributes.FirstName}}"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSMSTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "template_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "sms_template.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "sms_template.0.body", "Hello {{User.UserAttributes.FirstName}}"),
+					resource.TestCheckResourceAttr(resourceName, "sms_template.0.description", "testing"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccTemplateImportStateIDFunc(resourceName),
+				ImportStateVerifyIdentifierAttribute: "template_name",
+				ImportStateVerify:                    true,
+			},
+			{
+				Config: testAccSMSTemplateConfig_basic(rName, "Goodbye"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSMSTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "sms_template.0.body", "Goodbye"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPinpointSMSTemplate_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_pinpoint_sms_template.test"
+	var template pinpoint.GetSmsTemplateOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PinpointServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSMSTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSMSTemplateConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSMSTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				Config: testAccSMSTemplateConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSMSTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPinpointSMSTemplate_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_pinpoint_sms_template.test"
+	var template pinpoint.GetSmsTemplateOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PinpointServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSMSTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSMSTemplateConfig_basic(rName, "Hello"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSMSTemplateExists(ctx, resourceName, &template),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfpinpoint.ResourceSMSTemplate, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSMSTemplateExists(ctx context.Context, n string, v *pinpoint.GetSmsTemplateOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointClient(ctx)
+
+		output, err := tfpinpoint.FindSMSTemplateByName(ctx, conn, rs.Primary.Attributes["template_name"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckSMSTemplateDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_pinpoint_sms_template" {
+				continue
+			}
+
+			_, err := tfpinpoint.FindSMSTemplateByName(ctx, conn, rs.Primary.Attributes["template_name"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Pinpoint SMS Template %s still exists", rs.Primary.Attributes["template_name"])
+		}
+
+		return nil
+	}
+}
+
+func testAccTemplateImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["template_name"], nil
+	}
+}
+
+func testAccSMSTemplateConfig_basic(rName, body string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_sms_template" "test" {
+  template_name = %[1]q
+
+  sms_template {
+    body        = %[2]q
+    description = "testing"
+  }
+}
+`, rName, body)
+}
+
+func testAccSMSTemplateConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_sms_template" "test" {
+  template_name = %[1]q
+
+  sms_template {
+    body = "Hello"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
@@ -5,9 +5,13 @@ package pinpointsmsvoicev2
 
 // Exports for use in tests only.
 var (
-	ResourceOptOutList  = newOptOutListResource
-	ResourcePhoneNumber = newPhoneNumberResource
+	ResourceConfigurationSet = newConfigurationSetResource
+	ResourceOptOutList       = newOptOutListResource
+	ResourcePhoneNumber      = newPhoneNumberResource
+	ResourcePool             = newPoolResource
 
-	FindOptOutListByID  = findOptOutListByID
-	FindPhoneNumberByID = findPhoneNumberByID
+	FindConfigurationSetByID = findConfigurationSetByID
+	FindOptOutListByID       = findOptOutListByID
+	FindPhoneNumberByID      = findPhoneNumberByID
+	FindPoolByID             = findPoolByID
 )
@@ -0,0 +1,439 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pinpointsmsvoicev2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pinpointsmsvoicev2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pinpointsmsvoicev2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_pinpointsmsvoicev2_pool", name="Pool")
+// @Tags(identifierAttribute="arn")
+func newPoolResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &poolResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type poolResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*poolResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_pinpointsmsvoicev2_pool"
+}
+
+func (r *poolResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"deletion_protection_enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrID: framework.IDAttribute(),
+			"iso_country_code": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[A-Z]{2}$`), "must be in ISO 3166-1 alpha-2 format"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"message_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.MessageType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"opt_out_list_name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("Default"),
+			},
+			"origination_identity": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"self_managed_opt_outs_enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"shared_routes_enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"two_way_channel_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(
+						path.MatchRelative().AtParent().AtName("two_way_channel_enabled"),
+					),
+				},
+			},
+			"two_way_channel_enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *poolResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data poolResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PinpointSMSVoiceV2Client(ctx)
+
+	input := &pinpointsmsvoicev2.CreatePoolInput{
+		ClientToken:               aws.String(sdkid.UniqueId()),
+		DeletionProtectionEnabled: fwflex.BoolFromFramework(ctx, data.DeletionProtectionEnabled),
+		IsoCountryCode:            fwflex.StringFromFramework(ctx, data.ISOCountryCode),
+		MessageType:               data.MessageType.ValueEnum(),
+		OriginationIdentity:       fwflex.StringFromFramework(ctx, data.OriginationIdentity),
+		Tags:                      getTagsIn(ctx),
+	}
+
+	output, err := conn.CreatePool(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating End User Messaging SMS Pool", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.PoolID = fwflex.StringToFramework(ctx, output.PoolId)
+	response.State.SetAttribute(ctx, path.Root(names.AttrID), data.PoolID) // Set 'id' so as to taint the resource.
+
+	out, err := waitPoolActive(ctx, conn, data.PoolID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for End User Messaging SMS Pool (%s) create", data.PoolID.ValueString()), err.Error())
+
+		return
+	}
+
+	if data.OptOutListName.ValueString() != aws.ToString(out.OptOutListName) ||
+		data.SelfManagedOptOutsEnabled.ValueBool() ||
+		data.SharedRoutesEnabled.ValueBool() ||
+		!data.TwoWayChannelARN.IsNull() ||
+		data.TwoWayEnabled.ValueBool() {
+		input := &pinpointsmsvoicev2.UpdatePoolInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdatePool(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating End User Messaging SMS Pool (%s)", data.PoolID.ValueString()), err.Error())
+
+			return
+		}
+
+		out, err = waitPoolActive(ctx, conn, data.PoolID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for End User Messaging SMS Pool (%s) create", data.PoolID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, out, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *poolResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data poolResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PinpointSMSVoiceV2Client(ctx)
+
+	out, err := findPoolByID(ctx, conn, data.PoolID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading End User Messaging SMS Pool (%s)", data.PoolID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set attributes for import.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, out, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *poolResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new poolResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PinpointSMSVoiceV2Client(ctx)
+
+	if !new.DeletionProtectionEnabled.Equal(old.DeletionProtectionEnabled) ||
+		!new.OptOutListName.Equal(old.OptOutListName) ||
+		!new.SelfManagedOptOutsEnabled.Equal(old.SelfManagedOptOutsEnabled) ||
+		!new.SharedRoutesEnabled.Equal(old.SharedRoutesEnabled) ||
+		!new.TwoWayChannelARN.Equal(old.TwoWayChannelARN) ||
+		!new.TwoWayEnabled.Equal(old.TwoWayEnabled) {
+		input := &pinpointsmsvoicev2.UpdatePoolInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdatePool(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating End User Messaging SMS Pool (%s)", new.PoolID.ValueString()), err.Error())
+
+			return
+		}
+
+		if _, err := waitPoolActive(ctx, conn, new.PoolID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for End User Messaging SMS Pool (%s) update", new.PoolID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *poolResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data poolResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PinpointSMSVoiceV2Client(ctx)
+
+	_, err := conn.DeletePool(ctx, &pinpointsmsvoicev2.DeletePoolInput{
+		PoolId: data.PoolID.ValueStringPointer(),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting End User Messaging SMS Pool (%s)", data.PoolID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitPoolDeleted(ctx, conn, data.PoolID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for End User Messaging SMS Pool (%s) delete", data.PoolID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *poolResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+type poolResourceModel struct {
+	DeletionProtectionEnabled types.Bool                               `tfsdk:"deletion_protection_enabled"`
+	ISOCountryCode            types.String                             `tfsdk:"iso_country_code"`
+	MessageType               fwtypes.StringEnum[awstypes.MessageType] `tfsdk:"message_type"`
+	OptOutListName            types.String                             `tfsdk:"opt_out_list_name"`
+	OriginationIdentity       types.String                             `tfsdk:"origination_identity"`
+	PoolARN                   types.String                             `tfsdk:"arn"`
+	PoolID                    types.String                             `tfsdk:"id"`
+	SelfManagedOptOutsEnabled types.Bool                               `tfsdk:"self_managed_opt_outs_enabled"`
+	SharedRoutesEnabled       types.Bool                               `tfsdk:"shared_routes_enabled"`
+	Tags                      tftags.Map                               `tfsdk:"tags"`
+	TagsAll                   tftags.Map                               `tfsdk:"tags_all"`
+	Timeouts                  timeouts.Value                           `tfsdk:"timeouts"`
+	TwoWayChannelARN          fwtypes.ARN                              `tfsdk:"two_way_channel_arn"`
+	TwoWayEnabled             types.Bool                               `tfsdk:"two_way_channel_enabled"`
+}
+
+func findPoolByID(ctx context.Context, conn *pinpointsmsvoicev2.Client, id string) (*awstypes.PoolInformation, error) {
+	input := &pinpointsmsvoicev2.DescribePoolsInput{
+		PoolIds: []string{id},
+	}
+
+	output, err := findPool(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if status := output.Status; status == awstypes.PoolStatusDeleting {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findPool(ctx context.Context, conn *pinpointsmsvoicev2.Client, input *pinpointsmsvoicev2.DescribePoolsInput) (*awstypes.PoolInformation, error) {
+	output, err := findPools(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findPools(ctx context.Context, conn *pinpointsmsvoicev2.Client, input *pinpointsmsvoicev2.DescribePoolsInput) ([]awstypes.PoolInformation, error) {
+	var output []awstypes.PoolInformation
+
+	pages := pinpointsmsvoicev2.NewDescribePoolsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Pools...)
+	}
+
+	return output, nil
+}
+
+func statusPool(ctx context.Context, conn *pinpointsmsvoicev2.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findPoolByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitPoolActive(ctx context.Context, conn *pinpointsmsvoicev2.Client, id string, timeout time.Duration) (*awstypes.PoolInformation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.PoolStatusCreating),
+		Target:  enum.Slice(awstypes.PoolStatusActive),
+		Refresh: statusPool(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.PoolInformation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPoolDeleted(ctx context.Context, conn *pinpointsmsvoicev2.Client, id string, timeout time.Duration) (*awstypes.PoolInformation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.PoolStatusActive),
+		Target:  []string{},
+		Refresh: statusPool(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.PoolInformation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pinpointsmsvoicev2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/pinpointsmsvoicev2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pinpointsmsvoicev2/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpinpointsmsvoicev2 "github.com/hashicorp/terraform-provider-aws/internal/service/pinpointsmsvoicev2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccPinpointSMSVoiceV2Pool_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var pool awstypes.PoolInformation
+	resourceName := "aws_pinpointsmsvoicev2_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckPool(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PinpointSMSVoiceV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPoolDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoolConfig_basic(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPoolExists(ctx, resourceName, &pool),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrARN), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("message_type"), knownvalue.StringExact("TRANSACTIONAL")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("opt_out_list_name"), knownvalue.StringExact("Default")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("self_managed_opt_outs_enabled"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("shared_routes_enabled"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTagsAll), knownvalue.MapExact(map[string]knownvalue.Check{})),
+				},
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"iso_country_code", "origination_identity"},
+			},
+			{
+				Config: testAccPoolConfig_basic(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPoolExists(ctx, resourceName, &pool),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("self_managed_opt_outs_enabled"), knownvalue.Bool(true)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccPinpointSMSVoiceV2Pool_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var pool awstypes.PoolInformation
+	resourceName := "aws_pinpointsmsvoicev2_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckPool(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PinpointSMSVoiceV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPoolDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoolConfig_basic(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPoolExists(ctx, resourceName, &pool),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfpinpointsmsvoicev2.ResourcePool, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPoolDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointSMSVoiceV2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_pinpointsmsvoicev2_pool" {
+				continue
+			}
+
+			_, err := tfpinpointsmsvoicev2.FindPoolByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("End User Messaging SMS Pool %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPoolExists(ctx context.Context, n string, v *awstypes.PoolInformation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointSMSVoiceV2Client(ctx)
+
+		output, err := tfpinpointsmsvoicev2.FindPoolByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheckPool(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointSMSVoiceV2Client(ctx)
+
+	input := &pinpointsmsvoicev2.DescribePoolsInput{}
+
+	_, err := conn.DescribePools(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccPoolConfig_basic(selfManagedOptOutsEnabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_pinpointsmsvoicev2_phone_number" "test" {
+  iso_country_code = "US"
+  message_type     = "TRANSACTIONAL"
+  number_type      = "SIMULATOR"
+
+  number_capabilities = [
+    "SMS"
+  ]
+}
+
+resource "aws_pinpointsmsvoicev2_pool" "test" {
+  iso_country_code     = "US"
+  message_type         = "TRANSACTIONAL"
+  origination_identity = aws_pinpointsmsvoicev2_phone_number.test.arn
+
+  self_managed_opt_outs_enabled = %[1]t
+}
+`, selfManagedOptOutsEnabled)
+}
@@ -59,10 +59,10 @@ func TestAccRAMPrincipalAssociation_AccountID(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
-			acctest.PreCheckAlternateAccount(t)
+			acctest.PreCheckMultipleAccount(t, 2)
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.RAMServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesMultipleAccounts(ctx, t, 2),
 		CheckDestroy:             testAccCheckPrincipalAssociationDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
@@ -40,10 +40,10 @@ func TestAccRAMResourceShareAccepter_basic(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
-			acctest.PreCheckAlternateAccount(t)
+			acctest.PreCheckMultipleAccount(t, 2)
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.RAMServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesMultipleAccounts(ctx, t, 2),
 		CheckDestroy:             testAccCheckResourceShareAccepterDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
@@ -78,10 +78,10 @@ func TestAccRAMResourceShareAccepter_disappears(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
-			acctest.PreCheckAlternateAccount(t)
+			acctest.PreCheckMultipleAccount(t, 2)
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.RAMServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesMultipleAccounts(ctx, t, 2),
 		CheckDestroy:             testAccCheckResourceShareAccepterDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
@@ -105,10 +105,10 @@ func TestAccRAMResourceShareAccepter_resourceAssociation(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(ctx, t)
-			acctest.PreCheckAlternateAccount(t)
+			acctest.PreCheckMultipleAccount(t, 2)
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.RAMServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesMultipleAccounts(ctx, t, 2),
 		CheckDestroy:             testAccCheckResourceShareAccepterDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
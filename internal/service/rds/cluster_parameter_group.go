@@ -208,10 +208,15 @@ func resourceClusterParameterGroupUpdate(ctx context.Context, d *schema.Resource
 		o, n := d.GetChange(names.AttrParameter)
 		os, ns := o.(*schema.Set), n.(*schema.Set)
 
-		for chunk := range slices.Chunk(expandParameters(ns.Difference(os).List()), maxParamModifyChunk) {
+		// We can only modify 20 parameters at a time, so walk them until
+		// we've got them all, applying immediate parameters first.
+		for parameters := expandParameters(ns.Difference(os).List()); len(parameters) > 0; {
+			var paramsToModify []types.Parameter
+			paramsToModify, parameters = parameterGroupModifyChunk(parameters, maxParamModifyChunk)
+
 			input := &rds.ModifyDBClusterParameterGroupInput{
 				DBClusterParameterGroupName: aws.String(d.Id()),
-				Parameters:                  chunk,
+				Parameters:                  paramsToModify,
 			}
 
 			_, err := conn.ModifyDBClusterParameterGroup(ctx, input)
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 	"time"
 
@@ -282,26 +283,22 @@ func resourceParameterGroupUpdate(ctx context.Context, d *schema.ResourceData, m
 		}
 
 		// Reset parameters that have been removed.
-		if resetParameters := tfmaps.Values(toRemove); len(resetParameters) > 0 {
-			for resetParameters != nil {
-				var paramsToReset []types.Parameter
-				if len(resetParameters) <= maxParamModifyChunk {
-					paramsToReset, resetParameters = resetParameters[:], nil
-				} else {
-					paramsToReset, resetParameters = resetParameters[:maxParamModifyChunk], resetParameters[maxParamModifyChunk:]
-				}
-
-				input := &rds.ResetDBParameterGroupInput{
-					DBParameterGroupName: aws.String(d.Id()),
-					Parameters:           paramsToReset,
-					ResetAllParameters:   aws.Bool(false),
-				}
+		for chunk := range slices.Chunk(tfmaps.Values(toRemove), maxParamModifyChunk) {
+			input := &rds.ResetDBParameterGroupInput{
+				DBParameterGroupName: aws.String(d.Id()),
+				Parameters:           chunk,
+				ResetAllParameters:   aws.Bool(false),
+			}
 
-				_, err := conn.ResetDBParameterGroup(ctx, input)
+			const (
+				timeout = 3 * time.Minute
+			)
+			_, err := tfresource.RetryWhenIsAErrorMessageContains[*types.InvalidDBParameterGroupStateFault](ctx, timeout, func() (interface{}, error) {
+				return conn.ResetDBParameterGroup(ctx, input)
+			}, "has pending changes")
 
-				if err != nil {
-					return sdkdiag.AppendErrorf(diags, "resetting RDS DB Parameter Group (%s): %s", d.Id(), err)
-				}
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "resetting RDS DB Parameter Group (%s): %s", d.Id(), err)
 			}
 		}
 	}
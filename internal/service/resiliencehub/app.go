@@ -0,0 +1,336 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resiliencehub
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resiliencehub"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resiliencehub/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="App")
+// @Tags(identifierAttribute="arn")
+func newResourceApp(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceApp{}
+	r.SetDefaultDeleteTimeout(10 * time.Minute)
+
+	return r, nil
+}
+
+type resourceApp struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *resourceApp) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_resiliencehub_app"
+}
+
+func (r *resourceApp) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"assessment_schedule": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AppAssessmentScheduleType](),
+				Optional:   true,
+				Computed:   true,
+				Default:    fwtypes.StringEnumType[awstypes.AppAssessmentScheduleType]().AttributeDefault(awstypes.AppAssessmentScheduleTypeDisabled),
+			},
+			"compliance_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AppComplianceStatusType](),
+				Computed:   true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(500),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z][0-9A-Za-z_-]{1,59}$`), ""),
+				},
+			},
+			"resiliency_policy_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceApp) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data appResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResilienceHubClient(ctx)
+
+	name := data.Name.ValueString()
+	input := &resiliencehub.CreateAppInput{
+		AssessmentSchedule: data.AssessmentSchedule.ValueEnum(),
+		ClientToken:        aws.String(id.UniqueId()),
+		Description:        flex.StringFromFramework(ctx, data.Description),
+		Name:               aws.String(name),
+		PolicyArn:          flex.StringFromFramework(ctx, data.ResiliencyPolicyARN),
+		Tags:               getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateApp(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Resilience Hub App (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	arn := aws.ToString(output.App.AppArn)
+	data.ARN = types.StringValue(arn)
+	data.ID = types.StringValue(arn)
+	data.ComplianceStatus = fwtypes.StringEnumValue(output.App.ComplianceStatus)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceApp) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data appResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResilienceHubClient(ctx)
+
+	output, err := findAppByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Resilience Hub App (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ARN = flex.StringToFramework(ctx, output.AppArn)
+	data.AssessmentSchedule = fwtypes.StringEnumValue(output.AssessmentSchedule)
+	data.ComplianceStatus = fwtypes.StringEnumValue(output.ComplianceStatus)
+	data.Description = flex.StringToFramework(ctx, output.Description)
+	data.Name = flex.StringToFramework(ctx, output.Name)
+	data.ResiliencyPolicyARN = flex.StringToFrameworkARN(ctx, output.PolicyArn)
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceApp) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new appResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResilienceHubClient(ctx)
+
+	if !new.AssessmentSchedule.Equal(old.AssessmentSchedule) ||
+		!new.Description.Equal(old.Description) ||
+		!new.ResiliencyPolicyARN.Equal(old.ResiliencyPolicyARN) {
+		input := &resiliencehub.UpdateAppInput{
+			AppArn:             flex.StringFromFramework(ctx, new.ID),
+			AssessmentSchedule: new.AssessmentSchedule.ValueEnum(),
+			Description:        aws.String(new.Description.ValueString()),
+		}
+
+		if new.ResiliencyPolicyARN.IsNull() {
+			input.ClearResiliencyPolicyArn = aws.Bool(true)
+		} else {
+			input.PolicyArn = flex.StringFromFramework(ctx, new.ResiliencyPolicyARN)
+		}
+
+		output, err := conn.UpdateApp(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Resilience Hub App (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.ComplianceStatus = fwtypes.StringEnumValue(output.App.ComplianceStatus)
+	} else {
+		new.ComplianceStatus = old.ComplianceStatus
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceApp) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data appResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResilienceHubClient(ctx)
+
+	tflog.Debug(ctx, "deleting Resilience Hub App", map[string]interface{}{
+		names.AttrID: data.ID.ValueString(),
+	})
+	_, err := conn.DeleteApp(ctx, &resiliencehub.DeleteAppInput{
+		AppArn:      flex.StringFromFramework(ctx, data.ID),
+		ClientToken: aws.String(id.UniqueId()),
+		ForceDelete: aws.Bool(true),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Resilience Hub App (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitAppDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Resilience Hub App (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceApp) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+// See https://docs.aws.amazon.com/resilience-hub/latest/APIReference/API_App.html.
+type appResourceModel struct {
+	ARN                 types.String                                           `tfsdk:"arn"`
+	AssessmentSchedule  fwtypes.StringEnum[awstypes.AppAssessmentScheduleType] `tfsdk:"assessment_schedule"`
+	ComplianceStatus    fwtypes.StringEnum[awstypes.AppComplianceStatusType]   `tfsdk:"compliance_status"`
+	Description         types.String                                           `tfsdk:"description"`
+	ID                  types.String                                           `tfsdk:"id"`
+	Name                types.String                                           `tfsdk:"name"`
+	ResiliencyPolicyARN fwtypes.ARN                                            `tfsdk:"resiliency_policy_arn"`
+	Tags                tftags.Map                                             `tfsdk:"tags"`
+	TagsAll             tftags.Map                                             `tfsdk:"tags_all"`
+	Timeouts            timeouts.Value                                         `tfsdk:"timeouts"`
+}
+
+func findAppByARN(ctx context.Context, conn *resiliencehub.Client, arn string) (*awstypes.App, error) {
+	input := &resiliencehub.DescribeAppInput{
+		AppArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeApp(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.App == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.App, nil
+}
+
+func statusApp(ctx context.Context, conn *resiliencehub.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findAppByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitAppDeleted(ctx context.Context, conn *resiliencehub.Client, arn string, timeout time.Duration) (*awstypes.App, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AppStatusTypeActive, awstypes.AppStatusTypeDeleting),
+		Target:  []string{},
+		Refresh: statusApp(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.App); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resiliencehub_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resiliencehub/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfresiliencehub "github.com/hashicorp/terraform-provider-aws/internal/service/resiliencehub"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccResilienceHubApp_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.App
+	resourceName := "aws_resiliencehub_app.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResilienceHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAppExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "resiliencehub", regexache.MustCompile(`app/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "assessment_schedule", "Disabled"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckNoResourceAttr(resourceName, "resiliency_policy_arn"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrTimeouts},
+			},
+		},
+	})
+}
+
+func TestAccResilienceHubApp_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.App
+	resourceName := "aws_resiliencehub_app.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResilienceHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAppExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfresiliencehub.ResourceApp, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccResilienceHubApp_resiliencyPolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.App
+	resourceName := "aws_resiliencehub_app.test"
+	policyResourceName := "aws_resiliencehub_resiliency_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResilienceHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppConfig_resiliencyPolicy(rName, "Daily"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAppExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "assessment_schedule", "Daily"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Terraform acceptance test"),
+					resource.TestCheckResourceAttrPair(resourceName, "resiliency_policy_arn", policyResourceName, names.AttrARN),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrTimeouts},
+			},
+			{
+				Config: testAccAppConfig_resiliencyPolicy(rName, "Disabled"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAppExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "assessment_schedule", "Disabled"),
+				),
+			},
+			{
+				Config: testAccAppConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAppExists(ctx, resourceName, &v),
+					resource.TestCheckNoResourceAttr(resourceName, "resiliency_policy_arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAppDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResilienceHubClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_resiliencehub_app" {
+				continue
+			}
+
+			_, err := tfresiliencehub.FindAppByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Resilience Hub App %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAppExists(ctx context.Context, n string, v *awstypes.App) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResilienceHubClient(ctx)
+
+		output, err := tfresiliencehub.FindAppByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAppConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_resiliencehub_app" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAppConfig_resiliencyPolicy(rName, schedule string) string {
+	return acctest.ConfigCompose(testAccResiliencyPolicyConfig_basic(rName, "NonCritical", 3600), fmt.Sprintf(`
+resource "aws_resiliencehub_app" "test" {
+  name                  = %[1]q
+  description           = "Terraform acceptance test"
+  assessment_schedule   = %[2]q
+  resiliency_policy_arn = aws_resiliencehub_resiliency_policy.test.arn
+}
+`, rName, schedule))
+}
@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resiliencehub
+
+// Exports for use in tests only.
+var (
+	ResourceApp              = newResourceApp
+	ResourceResiliencyPolicy = newResourceResiliencyPolicy
+
+	FindAppByARN              = findAppByARN
+	FindResiliencyPolicyByARN = findResiliencyPolicyByARN
+)
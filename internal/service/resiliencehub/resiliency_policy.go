@@ -0,0 +1,446 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resiliencehub
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resiliencehub"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resiliencehub/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Resiliency Policy")
+// @Tags(identifierAttribute="arn")
+func newResourceResiliencyPolicy(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceResiliencyPolicy{}
+
+	return r, nil
+}
+
+type resourceResiliencyPolicy struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceResiliencyPolicy) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_resiliencehub_resiliency_policy"
+}
+
+func (r *resourceResiliencyPolicy) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	failurePolicyBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[failurePolicyModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"rpo_in_secs": schema.Int64Attribute{
+					Required: true,
+					Validators: []validator.Int64{
+						int64validator.AtLeast(0),
+					},
+				},
+				"rto_in_secs": schema.Int64Attribute{
+					Required: true,
+					Validators: []validator.Int64{
+						int64validator.AtLeast(0),
+					},
+				},
+			},
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"data_location_constraint": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DataLocationConstraint](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(500),
+				},
+			},
+			"estimated_cost_tier": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EstimatedCostTier](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 60),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"tier": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ResiliencyPolicyTier](),
+				Required:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrPolicy: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[policyModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"az":       failurePolicyBlock,
+						"hardware": failurePolicyBlock,
+						"region": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[failurePolicyModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: failurePolicyBlock.NestedObject,
+						},
+						"software": failurePolicyBlock,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceResiliencyPolicy) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resiliencyPolicyResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResilienceHubClient(ctx)
+
+	policy, diags := expandFailurePolicies(ctx, data.Policy)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	input := &resiliencehub.CreateResiliencyPolicyInput{
+		ClientToken:       aws.String(id.UniqueId()),
+		Policy:            policy,
+		PolicyDescription: flex.StringFromFramework(ctx, data.Description),
+		PolicyName:        aws.String(name),
+		Tags:              getTagsIn(ctx),
+		Tier:              data.Tier.ValueEnum(),
+	}
+
+	if !data.DataLocationConstraint.IsUnknown() && !data.DataLocationConstraint.IsNull() {
+		input.DataLocationConstraint = data.DataLocationConstraint.ValueEnum()
+	}
+
+	output, err := conn.CreateResiliencyPolicy(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Resilience Hub Resiliency Policy (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	arn := aws.ToString(output.Policy.PolicyArn)
+	data.ARN = types.StringValue(arn)
+	data.ID = types.StringValue(arn)
+	data.DataLocationConstraint = fwtypes.StringEnumValue(output.Policy.DataLocationConstraint)
+	data.EstimatedCostTier = fwtypes.StringEnumValue(output.Policy.EstimatedCostTier)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceResiliencyPolicy) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resiliencyPolicyResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResilienceHubClient(ctx)
+
+	output, err := findResiliencyPolicyByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Resilience Hub Resiliency Policy (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ARN = flex.StringToFramework(ctx, output.PolicyArn)
+	data.DataLocationConstraint = fwtypes.StringEnumValue(output.DataLocationConstraint)
+	data.Description = flex.StringToFramework(ctx, output.PolicyDescription)
+	data.EstimatedCostTier = fwtypes.StringEnumValue(output.EstimatedCostTier)
+	data.Name = flex.StringToFramework(ctx, output.PolicyName)
+	data.Tier = fwtypes.StringEnumValue(output.Tier)
+
+	policy, diags := flattenFailurePolicies(ctx, output.Policy)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.Policy = policy
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceResiliencyPolicy) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resiliencyPolicyResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResilienceHubClient(ctx)
+
+	if !new.DataLocationConstraint.Equal(old.DataLocationConstraint) ||
+		!new.Description.Equal(old.Description) ||
+		!new.Name.Equal(old.Name) ||
+		!new.Policy.Equal(old.Policy) ||
+		!new.Tier.Equal(old.Tier) {
+		policy, diags := expandFailurePolicies(ctx, new.Policy)
+		response.Diagnostics.Append(diags...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input := &resiliencehub.UpdateResiliencyPolicyInput{
+			Policy:            policy,
+			PolicyArn:         flex.StringFromFramework(ctx, new.ID),
+			PolicyDescription: aws.String(new.Description.ValueString()),
+			PolicyName:        flex.StringFromFramework(ctx, new.Name),
+			Tier:              new.Tier.ValueEnum(),
+		}
+
+		if !new.DataLocationConstraint.IsUnknown() && !new.DataLocationConstraint.IsNull() {
+			input.DataLocationConstraint = new.DataLocationConstraint.ValueEnum()
+		}
+
+		output, err := conn.UpdateResiliencyPolicy(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Resilience Hub Resiliency Policy (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.DataLocationConstraint = fwtypes.StringEnumValue(output.Policy.DataLocationConstraint)
+		new.EstimatedCostTier = fwtypes.StringEnumValue(output.Policy.EstimatedCostTier)
+	} else {
+		new.EstimatedCostTier = old.EstimatedCostTier
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceResiliencyPolicy) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resiliencyPolicyResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResilienceHubClient(ctx)
+
+	tflog.Debug(ctx, "deleting Resilience Hub Resiliency Policy", map[string]interface{}{
+		names.AttrID: data.ID.ValueString(),
+	})
+	_, err := conn.DeleteResiliencyPolicy(ctx, &resiliencehub.DeleteResiliencyPolicyInput{
+		ClientToken: aws.String(id.UniqueId()),
+		PolicyArn:   flex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Resilience Hub Resiliency Policy (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceResiliencyPolicy) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+// See https://docs.aws.amazon.com/resilience-hub/latest/APIReference/API_ResiliencyPolicy.html.
+type resiliencyPolicyResourceModel struct {
+	ARN                    types.String                                        `tfsdk:"arn"`
+	DataLocationConstraint fwtypes.StringEnum[awstypes.DataLocationConstraint] `tfsdk:"data_location_constraint"`
+	Description            types.String                                        `tfsdk:"description"`
+	EstimatedCostTier      fwtypes.StringEnum[awstypes.EstimatedCostTier]      `tfsdk:"estimated_cost_tier"`
+	ID                     types.String                                        `tfsdk:"id"`
+	Name                   types.String                                        `tfsdk:"name"`
+	Policy                 fwtypes.ListNestedObjectValueOf[policyModel]        `tfsdk:"policy"`
+	Tags                   tftags.Map                                          `tfsdk:"tags"`
+	TagsAll                tftags.Map                                          `tfsdk:"tags_all"`
+	Tier                   fwtypes.StringEnum[awstypes.ResiliencyPolicyTier]   `tfsdk:"tier"`
+}
+
+type policyModel struct {
+	AZ       fwtypes.ListNestedObjectValueOf[failurePolicyModel] `tfsdk:"az"`
+	Hardware fwtypes.ListNestedObjectValueOf[failurePolicyModel] `tfsdk:"hardware"`
+	Region   fwtypes.ListNestedObjectValueOf[failurePolicyModel] `tfsdk:"region"`
+	Software fwtypes.ListNestedObjectValueOf[failurePolicyModel] `tfsdk:"software"`
+}
+
+type failurePolicyModel struct {
+	RPOInSecs types.Int64 `tfsdk:"rpo_in_secs"`
+	RTOInSecs types.Int64 `tfsdk:"rto_in_secs"`
+}
+
+func expandFailurePolicies(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[policyModel]) (map[string]awstypes.FailurePolicy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || data == nil {
+		return nil, diags
+	}
+
+	apiObject := make(map[string]awstypes.FailurePolicy)
+
+	for k, v := range map[awstypes.DisruptionType]fwtypes.ListNestedObjectValueOf[failurePolicyModel]{
+		awstypes.DisruptionTypeAz:       data.AZ,
+		awstypes.DisruptionTypeHardware: data.Hardware,
+		awstypes.DisruptionTypeRegion:   data.Region,
+		awstypes.DisruptionTypeSoftware: data.Software,
+	} {
+		fp, d := v.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if fp == nil {
+			continue
+		}
+
+		apiObject[string(k)] = awstypes.FailurePolicy{
+			RpoInSecs: flex.Int32ValueFromFramework(ctx, fp.RPOInSecs),
+			RtoInSecs: flex.Int32ValueFromFramework(ctx, fp.RTOInSecs),
+		}
+	}
+
+	return apiObject, diags
+}
+
+func flattenFailurePolicies(ctx context.Context, apiObject map[string]awstypes.FailurePolicy) (fwtypes.ListNestedObjectValueOf[policyModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if len(apiObject) == 0 {
+		return fwtypes.NewListNestedObjectValueOfNull[policyModel](ctx), diags
+	}
+
+	flatten := func(k awstypes.DisruptionType) fwtypes.ListNestedObjectValueOf[failurePolicyModel] {
+		v, ok := apiObject[string(k)]
+		if !ok {
+			return fwtypes.NewListNestedObjectValueOfNull[failurePolicyModel](ctx)
+		}
+
+		return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &failurePolicyModel{
+			RPOInSecs: flex.Int32ValueToFramework(ctx, v.RpoInSecs),
+			RTOInSecs: flex.Int32ValueToFramework(ctx, v.RtoInSecs),
+		})
+	}
+
+	tfList, d := fwtypes.NewListNestedObjectValueOfPtr(ctx, &policyModel{
+		AZ:       flatten(awstypes.DisruptionTypeAz),
+		Hardware: flatten(awstypes.DisruptionTypeHardware),
+		Region:   flatten(awstypes.DisruptionTypeRegion),
+		Software: flatten(awstypes.DisruptionTypeSoftware),
+	})
+	diags.Append(d...)
+
+	return tfList, diags
+}
+
+func findResiliencyPolicyByARN(ctx context.Context, conn *resiliencehub.Client, arn string) (*awstypes.ResiliencyPolicy, error) {
+	input := &resiliencehub.DescribeResiliencyPolicyInput{
+		PolicyArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeResiliencyPolicy(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Policy == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Policy, nil
+}
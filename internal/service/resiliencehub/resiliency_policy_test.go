@@ -0,0 +1,324 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resiliencehub_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resiliencehub/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfresiliencehub "github.com/hashicorp/terraform-provider-aws/internal/service/resiliencehub"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccResilienceHubResiliencyPolicy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ResiliencyPolicy
+	resourceName := "aws_resiliencehub_resiliency_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResilienceHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResiliencyPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResiliencyPolicyConfig_basic(rName, "NonCritical", 3600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResiliencyPolicyExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "resiliencehub", regexache.MustCompile(`resiliency-policy/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "data_location_constraint"),
+					resource.TestCheckResourceAttrSet(resourceName, "estimated_cost_tier"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "policy.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.az.0.rpo_in_secs", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.region.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "tier", "NonCritical"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResiliencyPolicyConfig_basic(rName, "Important", 1800),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResiliencyPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.az.0.rpo_in_secs", "1800"),
+					resource.TestCheckResourceAttr(resourceName, "tier", "Important"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResilienceHubResiliencyPolicy_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ResiliencyPolicy
+	resourceName := "aws_resiliencehub_resiliency_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResilienceHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResiliencyPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResiliencyPolicyConfig_basic(rName, "NonCritical", 3600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResiliencyPolicyExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfresiliencehub.ResourceResiliencyPolicy, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccResilienceHubResiliencyPolicy_region(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ResiliencyPolicy
+	resourceName := "aws_resiliencehub_resiliency_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResilienceHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResiliencyPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResiliencyPolicyConfig_region(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResiliencyPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "data_location_constraint", "SameContinent"),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.region.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.region.0.rpo_in_secs", "86400"),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.region.0.rto_in_secs", "86400"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResilienceHubResiliencyPolicy_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ResiliencyPolicy
+	resourceName := "aws_resiliencehub_resiliency_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResilienceHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResiliencyPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResiliencyPolicyConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResiliencyPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResiliencyPolicyConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResiliencyPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccResiliencyPolicyConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResiliencyPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckResiliencyPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResilienceHubClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_resiliencehub_resiliency_policy" {
+				continue
+			}
+
+			_, err := tfresiliencehub.FindResiliencyPolicyByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Resilience Hub Resiliency Policy %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckResiliencyPolicyExists(ctx context.Context, n string, v *awstypes.ResiliencyPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResilienceHubClient(ctx)
+
+		output, err := tfresiliencehub.FindResiliencyPolicyByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccResiliencyPolicyConfig_basic(rName, tier string, rpo int) string {
+	return fmt.Sprintf(`
+resource "aws_resiliencehub_resiliency_policy" "test" {
+  name = %[1]q
+  tier = %[2]q
+
+  policy {
+    az {
+      rpo_in_secs = %[3]d
+      rto_in_secs = 3600
+    }
+    hardware {
+      rpo_in_secs = %[3]d
+      rto_in_secs = 3600
+    }
+    software {
+      rpo_in_secs = %[3]d
+      rto_in_secs = 3600
+    }
+  }
+}
+`, rName, tier, rpo)
+}
+
+func testAccResiliencyPolicyConfig_region(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_resiliencehub_resiliency_policy" "test" {
+  name                     = %[1]q
+  description              = "Terraform acceptance test"
+  tier                     = "Critical"
+  data_location_constraint = "SameContinent"
+
+  policy {
+    az {
+      rpo_in_secs = 60
+      rto_in_secs = 300
+    }
+    hardware {
+      rpo_in_secs = 60
+      rto_in_secs = 300
+    }
+    software {
+      rpo_in_secs = 60
+      rto_in_secs = 300
+    }
+    region {
+      rpo_in_secs = 86400
+      rto_in_secs = 86400
+    }
+  }
+}
+`, rName)
+}
+
+func testAccResiliencyPolicyConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_resiliencehub_resiliency_policy" "test" {
+  name = %[1]q
+  tier = "NonCritical"
+
+  policy {
+    az {
+      rpo_in_secs = 3600
+      rto_in_secs = 3600
+    }
+    hardware {
+      rpo_in_secs = 3600
+      rto_in_secs = 3600
+    }
+    software {
+      rpo_in_secs = 3600
+      rto_in_secs = 3600
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccResiliencyPolicyConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_resiliencehub_resiliency_policy" "test" {
+  name = %[1]q
+  tier = "NonCritical"
+
+  policy {
+    az {
+      rpo_in_secs = 3600
+      rto_in_secs = 3600
+    }
+    hardware {
+      rpo_in_secs = 3600
+      rto_in_secs = 3600
+    }
+    software {
+      rpo_in_secs = 3600
+      rto_in_secs = 3600
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
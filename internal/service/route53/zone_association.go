@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/route53"
@@ -36,6 +37,11 @@ func resourceZoneAssociation() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"owning_account": {
 				Type:     schema.TypeString,
@@ -82,7 +88,12 @@ func resourceZoneAssociationCreate(ctx context.Context, d *schema.ResourceData,
 		},
 	}
 
-	output, err := conn.AssociateVPCWithHostedZone(ctx, input)
+	outputRaw, err := tfresource.RetryWhenIsA[*awstypes.ConcurrentModification](ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+		// A cross-account VPC association authorization created in the same apply may not be visible yet.
+		return tfresource.RetryWhenIsA[*awstypes.NotAuthorizedException](ctx, propagationTimeout, func() (interface{}, error) {
+			return conn.AssociateVPCWithHostedZone(ctx, input)
+		})
+	})
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating Route 53 Zone Association (%s): %s", id, err)
@@ -90,7 +101,7 @@ func resourceZoneAssociationCreate(ctx context.Context, d *schema.ResourceData,
 
 	d.SetId(id)
 
-	if output.ChangeInfo != nil {
+	if output := outputRaw.(*route53.AssociateVPCWithHostedZoneOutput); output.ChangeInfo != nil {
 		if _, err := waitChangeInsync(ctx, conn, aws.ToString(output.ChangeInfo.Id)); err != nil {
 			// AccessDenied errors likely due to cross-account issue.
 			if !tfawserr.ErrCodeEquals(err, errCodeAccessDenied) {
@@ -119,7 +130,9 @@ func resourceZoneAssociationRead(ctx context.Context, d *schema.ResourceData, me
 		vpcRegion = meta.(*conns.AWSClient).Region
 	}
 
-	hostedZoneSummary, err := findZoneAssociationByThreePartKey(ctx, conn, zoneID, vpcID, vpcRegion)
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+		return findZoneAssociationByThreePartKey(ctx, conn, zoneID, vpcID, vpcRegion)
+	}, d.IsNewResource())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Route 53 Zone Association %s not found, removing from state", d.Id())
@@ -131,6 +144,7 @@ func resourceZoneAssociationRead(ctx context.Context, d *schema.ResourceData, me
 		return sdkdiag.AppendErrorf(diags, "reading Route 53 Zone Association (%s): %s", d.Id(), err)
 	}
 
+	hostedZoneSummary := outputRaw.(*awstypes.HostedZoneSummary)
 	d.Set("owning_account", hostedZoneSummary.Owner.OwningAccount)
 	d.Set(names.AttrVPCID, vpcID)
 	d.Set("vpc_region", vpcRegion)
@@ -157,13 +171,15 @@ func resourceZoneAssociationDelete(ctx context.Context, d *schema.ResourceData,
 	}
 
 	log.Printf("[INFO] Deleting Route53 Zone Association: %s", d.Id())
-	output, err := conn.DisassociateVPCFromHostedZone(ctx, &route53.DisassociateVPCFromHostedZoneInput{
-		Comment:      aws.String("Managed by Terraform"),
-		HostedZoneId: aws.String(zoneID),
-		VPC: &awstypes.VPC{
-			VPCId:     aws.String(vpcID),
-			VPCRegion: awstypes.VPCRegion(vpcRegion),
-		},
+	outputRaw, err := tfresource.RetryWhenIsA[*awstypes.ConcurrentModification](ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DisassociateVPCFromHostedZone(ctx, &route53.DisassociateVPCFromHostedZoneInput{
+			Comment:      aws.String("Managed by Terraform"),
+			HostedZoneId: aws.String(zoneID),
+			VPC: &awstypes.VPC{
+				VPCId:     aws.String(vpcID),
+				VPCRegion: awstypes.VPCRegion(vpcRegion),
+			},
+		})
 	})
 
 	if errs.IsA[*awstypes.NoSuchHostedZone](err) || errs.IsA[*awstypes.VPCAssociationNotFound](err) {
@@ -174,7 +190,7 @@ func resourceZoneAssociationDelete(ctx context.Context, d *schema.ResourceData,
 		return sdkdiag.AppendErrorf(diags, "deleting Route 53 Zone Association (%s): %s", d.Id(), err)
 	}
 
-	if output.ChangeInfo != nil {
+	if output := outputRaw.(*route53.DisassociateVPCFromHostedZoneOutput); output.ChangeInfo != nil {
 		if _, err := waitChangeInsync(ctx, conn, aws.ToString(output.ChangeInfo.Id)); err != nil {
 			// AccessDenied errors likely due to cross-account issue.
 			if !tfawserr.ErrCodeEquals(err, errCodeAccessDenied) {
@@ -76,6 +76,12 @@ func resourceEndpoint() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"ipv6": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IsIPv6Address,
+						},
 						names.AttrSubnetID: {
 							Type:     schema.TypeString,
 							Required: true,
@@ -434,6 +440,9 @@ func endpointHashIPAddress(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
 	buf.WriteString(fmt.Sprintf("%s-%s-", m[names.AttrSubnetID].(string), m["ip"].(string)))
+	if v, ok := m["ipv6"].(string); ok && v != "" {
+		buf.WriteString(fmt.Sprintf("%s-", v))
+	}
 	return create.StringHashcode(buf.String())
 }
 
@@ -451,6 +460,9 @@ func expandEndpointIPAddressUpdate(vIpAddress interface{}) *awstypes.IpAddressUp
 	if vIpId, ok := mIpAddress["ip_id"].(string); ok && vIpId != "" {
 		ipAddressUpdate.IpId = aws.String(vIpId)
 	}
+	if vIpv6, ok := mIpAddress["ipv6"].(string); ok && vIpv6 != "" {
+		ipAddressUpdate.Ipv6 = aws.String(vIpv6)
+	}
 
 	return ipAddressUpdate
 }
@@ -469,6 +481,9 @@ func expandEndpointIPAddresses(vIpAddresses *schema.Set) []awstypes.IpAddressReq
 		if vIp, ok := mIpAddress["ip"].(string); ok && vIp != "" {
 			ipAddressRequest.Ip = aws.String(vIp)
 		}
+		if vIpv6, ok := mIpAddress["ipv6"].(string); ok && vIpv6 != "" {
+			ipAddressRequest.Ipv6 = aws.String(vIpv6)
+		}
 
 		ipAddressRequests = append(ipAddressRequests, ipAddressRequest)
 	}
@@ -488,6 +503,7 @@ func flattenEndpointIPAddresses(ipAddresses []awstypes.IpAddressResponse) []inte
 			names.AttrSubnetID: aws.ToString(ipAddress.SubnetId),
 			"ip":               aws.ToString(ipAddress.Ip),
 			"ip_id":            aws.ToString(ipAddress.IpId),
+			"ipv6":             aws.ToString(ipAddress.Ipv6),
 		}
 
 		vIpAddresses = append(vIpAddresses, mIpAddress)
@@ -195,6 +195,42 @@ func TestAccRoute53ResolverEndpoint_resolverEndpointType(t *testing.T) {
 	})
 }
 
+func TestAccRoute53ResolverEndpoint_ipv6AddressesSameSubnet(t *testing.T) {
+	ctx := acctest.Context(t)
+	var ep awstypes.ResolverEndpoint
+	resourceName := "aws_route53_resolver_endpoint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ResolverServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEndpointConfig_ipv6AddressesSameSubnet(rName, 10, 11),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointExists(ctx, resourceName, &ep),
+					resource.TestCheckResourceAttr(resourceName, "resolver_endpoint_type", "IPV6"),
+					resource.TestCheckResourceAttr(resourceName, "ip_address.#", acctest.Ct2),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEndpointConfig_ipv6AddressesSameSubnet(rName, 10, 12),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointExists(ctx, resourceName, &ep),
+					resource.TestCheckResourceAttr(resourceName, "ip_address.#", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckEndpointDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverClient(ctx)
@@ -480,3 +516,58 @@ resource "aws_route53_resolver_endpoint" "test" {
 }
 `, rName, resolverEndpointType))
 }
+
+func testAccEndpointConfig_ipv6AddressesSameSubnet(rName string, hostNum1, hostNum2 int) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block           = "10.0.0.0/16"
+  enable_dns_support   = true
+  enable_dns_hostnames = true
+
+  assign_generated_ipv6_cidr_block = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  vpc_id            = aws_vpc.test.id
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = cidrsubnet(aws_vpc.test.cidr_block, 8, 0)
+
+  ipv6_cidr_block                 = cidrsubnet(aws_vpc.test.ipv6_cidr_block, 8, 0)
+  assign_ipv6_address_on_creation = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test" {
+  vpc_id = aws_vpc.test.id
+  name   = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route53_resolver_endpoint" "test" {
+  direction              = "INBOUND"
+  resolver_endpoint_type = "IPV6"
+
+  security_group_ids = [aws_security_group.test.id]
+
+  ip_address {
+    subnet_id = aws_subnet.test.id
+    ipv6      = cidrhost(aws_subnet.test.ipv6_cidr_block, %[2]d)
+  }
+
+  ip_address {
+    subnet_id = aws_subnet.test.id
+    ipv6      = cidrhost(aws_subnet.test.ipv6_cidr_block, %[3]d)
+  }
+}
+`, rName, hostNum1, hostNum2))
+}
@@ -92,9 +92,14 @@ func resourceRule() *schema.Resource {
 					Schema: map[string]*schema.Schema{
 						"ip": {
 							Type:         schema.TypeString,
-							Required:     true,
+							Optional:     true,
 							ValidateFunc: validation.IsIPAddress,
 						},
+						"ipv6": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsIPv6Address,
+						},
 						names.AttrPort: {
 							Type:         schema.TypeInt,
 							Optional:     true,
@@ -260,6 +265,20 @@ func resourceRuleCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v i
 		}
 	}
 
+	if targetIPs := diff.GetRawConfig().GetAttr("target_ip"); targetIPs.IsKnown() && !targetIPs.IsNull() {
+		for it := targetIPs.ElementIterator(); it.Next(); {
+			_, targetIP := it.Element()
+
+			if !targetIP.IsKnown() || targetIP.IsNull() {
+				continue
+			}
+
+			if targetIP.GetAttr("ip").IsNull() && targetIP.GetAttr("ipv6").IsNull() {
+				return errors.New("each target_ip must specify ip or ipv6")
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -377,6 +396,9 @@ func expandRuleTargetIPs(vTargetIps *schema.Set) []awstypes.TargetAddress {
 		if vIp, ok := mTargetIp["ip"].(string); ok && vIp != "" {
 			targetAddress.Ip = aws.String(vIp)
 		}
+		if vIpv6, ok := mTargetIp["ipv6"].(string); ok && vIpv6 != "" {
+			targetAddress.Ipv6 = aws.String(vIpv6)
+		}
 		if vPort, ok := mTargetIp[names.AttrPort].(int); ok {
 			targetAddress.Port = aws.Int32(int32(vPort))
 		}
@@ -400,6 +422,7 @@ func flattenRuleTargetIPs(targetAddresses []awstypes.TargetAddress) []interface{
 	for _, targetAddress := range targetAddresses {
 		mTargetIp := map[string]interface{}{
 			"ip":               aws.ToString(targetAddress.Ip),
+			"ipv6":             aws.ToString(targetAddress.Ipv6),
 			names.AttrPort:     int(aws.ToInt32(targetAddress.Port)),
 			names.AttrProtocol: targetAddress.Protocol,
 		}
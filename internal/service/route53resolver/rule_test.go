@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -376,6 +377,64 @@ func TestAccRoute53ResolverRule_forwardMultiProtocol(t *testing.T) {
 	})
 }
 
+func TestAccRoute53ResolverRule_forwardIPv6(t *testing.T) {
+	ctx := acctest.Context(t)
+	var rule awstypes.ResolverRule
+	resourceName := "aws_route53_resolver_rule.test"
+	domainName := acctest.RandomDomainName()
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ResolverServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleConfig_forwardIPv6(rName, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleExists(ctx, resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "target_ip.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "target_ip.*", map[string]string{
+						"ip":               "192.0.2.6",
+						names.AttrPort:     "53",
+						names.AttrProtocol: "Do53",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "target_ip.*", map[string]string{
+						"ipv6":             "2001:db8::6",
+						names.AttrPort:     "5353",
+						names.AttrProtocol: "Do53",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRoute53ResolverRule_forwardTargetIPNoAddress(t *testing.T) {
+	ctx := acctest.Context(t)
+	domainName := acctest.RandomDomainName()
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ResolverServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRuleConfig_forwardTargetIPNoAddress(rName, domainName),
+				ExpectError: regexache.MustCompile(`each target_ip must specify ip or ipv6`),
+			},
+		},
+	})
+}
+
 func TestAccRoute53ResolverRule_forwardEndpointRecreate(t *testing.T) {
 	ctx := acctest.Context(t)
 	var rule1, rule2 awstypes.ResolverRule
@@ -574,6 +633,79 @@ resource "aws_route53_resolver_rule" "test" {
 `, rName, domainName, protocol))
 }
 
+func testAccRuleConfig_forwardIPv6(rName, domainName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block           = "10.0.0.0/16"
+  enable_dns_support   = true
+  enable_dns_hostnames = true
+
+  assign_generated_ipv6_cidr_block = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  count = 2
+
+  vpc_id            = aws_vpc.test.id
+  availability_zone = data.aws_availability_zones.available.names[count.index]
+  cidr_block        = cidrsubnet(aws_vpc.test.cidr_block, 8, count.index)
+
+  ipv6_cidr_block                 = cidrsubnet(aws_vpc.test.ipv6_cidr_block, 8, count.index)
+  assign_ipv6_address_on_creation = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test" {
+  vpc_id = aws_vpc.test.id
+  name   = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route53_resolver_endpoint" "test" {
+  direction              = "OUTBOUND"
+  name                   = %[1]q
+  resolver_endpoint_type = "DUALSTACK"
+
+  security_group_ids = [aws_security_group.test.id]
+
+  ip_address {
+    subnet_id = aws_subnet.test[0].id
+  }
+
+  ip_address {
+    subnet_id = aws_subnet.test[1].id
+  }
+}
+
+resource "aws_route53_resolver_rule" "test" {
+  domain_name = %[2]q
+  rule_type   = "FORWARD"
+  name        = %[1]q
+
+  resolver_endpoint_id = aws_route53_resolver_endpoint.test.id
+
+  target_ip {
+    ip = "192.0.2.6"
+  }
+
+  target_ip {
+    ipv6 = "2001:db8::6"
+    port = 5353
+  }
+}
+`, rName, domainName))
+}
+
 func testAccRuleConfig_forwardTargetIPChanged(rName, domainName string) string {
 	return acctest.ConfigCompose(testAccRuleConfig_resolverEndpointBase(rName), fmt.Sprintf(`
 resource "aws_route53_resolver_rule" "test" {
@@ -733,3 +865,18 @@ resource "aws_route53_resolver_endpoint" "test" {
 }
 `, rName))
 }
+
+func testAccRuleConfig_forwardTargetIPNoAddress(rName, domainName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_resolver_rule" "test" {
+  domain_name          = %[2]q
+  rule_type            = "FORWARD"
+  name                 = %[1]q
+  resolver_endpoint_id = "rslvr-out-0123456789abcdef0"
+
+  target_ip {
+    port = 53
+  }
+}
+`, rName, domainName)
+}
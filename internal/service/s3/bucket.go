@@ -35,6 +35,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -797,6 +798,9 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s): %s", d.Id(), err)
 	}
 
+	// Sub-resources are always read after create or import (when 'bucket' is not yet set).
+	skipUnsetSubresources := meta.(*conns.AWSClient).SkipUnsetSubresourceReads(ctx) && !d.IsNewResource() && d.Get(names.AttrBucket).(string) != ""
+
 	arn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   "s3",
@@ -811,9 +815,9 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	// Bucket Policy.
 	//
 	// Read the policy if configured outside this resource e.g. with aws_s3_bucket_policy resource.
-	policy, err := retryWhenNoSuchBucketError(ctx, d.Timeout(schema.TimeoutRead), func() (string, error) {
+	policy, err := readBucketSubresource(ctx, d, skipUnsetSubresources, func() (string, error) {
 		return findBucketPolicy(ctx, conn, d.Id())
-	})
+	}, names.AttrPolicy)
 
 	// The call to HeadBucket above can occasionally return no error (i.e. NoSuchBucket)
 	// after a bucket has been deleted (eventual consistency woes :/), thus, when making extra S3 API calls
@@ -825,6 +829,8 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	switch {
+	case errors.Is(err, errSubresourceReadSkipped):
+		// Keep the value in state.
 	case err == nil:
 		policyToSet, err := verify.PolicyToSet(d.Get(names.AttrPolicy).(string), policy)
 		if err != nil {
@@ -841,9 +847,9 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	//
 	// Bucket ACL.
 	//
-	bucketACL, err := retryWhenNoSuchBucketError(ctx, d.Timeout(schema.TimeoutRead), func() (*s3.GetBucketAclOutput, error) {
+	bucketACL, err := readBucketSubresource(ctx, d, skipUnsetSubresources, func() (*s3.GetBucketAclOutput, error) {
 		return findBucketACL(ctx, conn, d.Id(), "")
-	})
+	}, "grant")
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
 		log.Printf("[WARN] S3 Bucket (%s) not found, removing from state", d.Id())
@@ -852,6 +858,8 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	switch {
+	case errors.Is(err, errSubresourceReadSkipped):
+		// Keep the value in state.
 	case err == nil:
 		if err := d.Set("grant", flattenBucketGrants(bucketACL)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting grant: %s", err)
@@ -865,9 +873,9 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	//
 	// Bucket CORS Configuration.
 	//
-	corsRules, err := retryWhenNoSuchBucketError(ctx, d.Timeout(schema.TimeoutRead), func() ([]types.CORSRule, error) {
+	corsRules, err := readBucketSubresource(ctx, d, skipUnsetSubresources, func() ([]types.CORSRule, error) {
 		return findCORSRules(ctx, conn, d.Id(), "")
-	})
+	}, "cors_rule")
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
 		log.Printf("[WARN] S3 Bucket (%s) not found, removing from state", d.Id())
@@ -876,6 +884,8 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	switch {
+	case errors.Is(err, errSubresourceReadSkipped):
+		// Keep the value in state.
 	case err == nil:
 		if err := d.Set("cors_rule", flattenBucketCORSRules(corsRules)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting cors_rule: %s", err)
@@ -889,9 +899,9 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	//
 	// Bucket Website Configuration.
 	//
-	bucketWebsite, err := retryWhenNoSuchBucketError(ctx, d.Timeout(schema.TimeoutRead), func() (*s3.GetBucketWebsiteOutput, error) {
+	bucketWebsite, err := readBucketSubresource(ctx, d, skipUnsetSubresources, func() (*s3.GetBucketWebsiteOutput, error) {
 		return findBucketWebsite(ctx, conn, d.Id(), "")
-	})
+	}, "website")
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
 		log.Printf("[WARN] S3 Bucket (%s) not found, removing from state", d.Id())
@@ -900,6 +910,8 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	switch {
+	case errors.Is(err, errSubresourceReadSkipped):
+		// Keep the value in state.
 	case err == nil:
 		website, err := flattenBucketWebsite(bucketWebsite)
 		if err != nil {
@@ -917,9 +929,9 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	//
 	// Bucket Versioning.
 	//
-	bucketVersioning, err := retryWhenNoSuchBucketError(ctx, d.Timeout(schema.TimeoutRead), func() (*s3.GetBucketVersioningOutput, error) {
+	bucketVersioning, err := readBucketSubresource(ctx, d, skipUnsetSubresources, func() (*s3.GetBucketVersioningOutput, error) {
 		return findBucketVersioning(ctx, conn, d.Id(), "")
-	})
+	}, "versioning")
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
 		log.Printf("[WARN] S3 Bucket (%s) not found, removing from state", d.Id())
@@ -928,6 +940,8 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	switch {
+	case errors.Is(err, errSubresourceReadSkipped):
+		// Keep the value in state.
 	case err == nil:
 		if err := d.Set("versioning", flattenBucketVersioning(bucketVersioning)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting versioning: %s", err)
@@ -941,9 +955,9 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	//
 	// Bucket Accelerate Configuration.
 	//
-	bucketAccelerate, err := retryWhenNoSuchBucketError(ctx, d.Timeout(schema.TimeoutRead), func() (*s3.GetBucketAccelerateConfigurationOutput, error) {
+	bucketAccelerate, err := readBucketSubresource(ctx, d, skipUnsetSubresources, func() (*s3.GetBucketAccelerateConfigurationOutput, error) {
 		return findBucketAccelerateConfiguration(ctx, conn, d.Id(), "")
-	})
+	}, "acceleration_status")
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
 		log.Printf("[WARN] S3 Bucket (%s) not found, removing from state", d.Id())
@@ -952,6 +966,8 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	switch {
+	case errors.Is(err, errSubresourceReadSkipped):
+		// Keep the value in state.
 	case err == nil:
 		d.Set("acceleration_status", bucketAccelerate.Status)
 	case tfresource.NotFound(err), tfawserr.ErrCodeEquals(err, errCodeMethodNotAllowed, errCodeNotImplemented, errCodeXNotImplemented, errCodeUnsupportedArgument, errCodeUnsupportedOperation):
@@ -963,9 +979,9 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	//
 	// Bucket Request Payment Configuration.
 	//
-	bucketRequestPayment, err := retryWhenNoSuchBucketError(ctx, d.Timeout(schema.TimeoutRead), func() (*s3.GetBucketRequestPaymentOutput, error) {
+	bucketRequestPayment, err := readBucketSubresource(ctx, d, skipUnsetSubresources, func() (*s3.GetBucketRequestPaymentOutput, error) {
 		return findBucketRequestPayment(ctx, conn, d.Id(), "")
-	})
+	}, "request_payer")
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
 		log.Printf("[WARN] S3 Bucket (%s) not found, removing from state", d.Id())
@@ -974,6 +990,8 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	switch {
+	case errors.Is(err, errSubresourceReadSkipped):
+		// Keep the value in state.
 	case err == nil:
 		d.Set("request_payer", bucketRequestPayment.Payer)
 	case tfresource.NotFound(err), tfawserr.ErrCodeEquals(err, errCodeMethodNotAllowed, errCodeNotImplemented, errCodeXNotImplemented):
@@ -985,9 +1003,9 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	//
 	// Bucket Logging.
 	//
-	loggingEnabled, err := retryWhenNoSuchBucketError(ctx, d.Timeout(schema.TimeoutRead), func() (*types.LoggingEnabled, error) {
+	loggingEnabled, err := readBucketSubresource(ctx, d, skipUnsetSubresources, func() (*types.LoggingEnabled, error) {
 		return findLoggingEnabled(ctx, conn, d.Id(), "")
-	})
+	}, "logging")
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
 		log.Printf("[WARN] S3 Bucket (%s) not found, removing from state", d.Id())
@@ -996,6 +1014,8 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	switch {
+	case errors.Is(err, errSubresourceReadSkipped):
+		// Keep the value in state.
 	case err == nil:
 		if err := d.Set("logging", flattenBucketLoggingEnabled(loggingEnabled)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting logging: %s", err)
@@ -1009,9 +1029,9 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	//
 	// Bucket Lifecycle Configuration.
 	//
-	lifecycleRules, err := retryWhenNoSuchBucketError(ctx, d.Timeout(schema.TimeoutRead), func() ([]types.LifecycleRule, error) {
+	lifecycleRules, err := readBucketSubresource(ctx, d, skipUnsetSubresources, func() ([]types.LifecycleRule, error) {
 		return findLifecycleRules(ctx, conn, d.Id(), "")
-	})
+	}, "lifecycle_rule")
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
 		log.Printf("[WARN] S3 Bucket (%s) not found, removing from state", d.Id())
@@ -1020,6 +1040,8 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	switch {
+	case errors.Is(err, errSubresourceReadSkipped):
+		// Keep the value in state.
 	case err == nil:
 		if err := d.Set("lifecycle_rule", flattenBucketLifecycleRules(ctx, lifecycleRules)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting lifecycle_rule: %s", err)
@@ -1033,9 +1055,9 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	//
 	// Bucket Replication Configuration.
 	//
-	replicationConfiguration, err := retryWhenNoSuchBucketError(ctx, d.Timeout(schema.TimeoutRead), func() (*types.ReplicationConfiguration, error) {
+	replicationConfiguration, err := readBucketSubresource(ctx, d, skipUnsetSubresources, func() (*types.ReplicationConfiguration, error) {
 		return findReplicationConfiguration(ctx, conn, d.Id())
-	})
+	}, "replication_configuration")
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
 		log.Printf("[WARN] S3 Bucket (%s) not found, removing from state", d.Id())
@@ -1044,6 +1066,8 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	switch {
+	case errors.Is(err, errSubresourceReadSkipped):
+		// Keep the value in state.
 	case err == nil:
 		if err := d.Set("replication_configuration", flattenBucketReplicationConfiguration(ctx, replicationConfiguration)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting replication_configuration: %s", err)
@@ -1057,9 +1081,9 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	//
 	// Bucket Server-side Encryption Configuration.
 	//
-	encryptionConfiguration, err := retryWhenNoSuchBucketError(ctx, d.Timeout(schema.TimeoutRead), func() (*types.ServerSideEncryptionConfiguration, error) {
+	encryptionConfiguration, err := readBucketSubresource(ctx, d, skipUnsetSubresources, func() (*types.ServerSideEncryptionConfiguration, error) {
 		return findServerSideEncryptionConfiguration(ctx, conn, d.Id(), "")
-	})
+	}, "server_side_encryption_configuration")
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
 		log.Printf("[WARN] S3 Bucket (%s) not found, removing from state", d.Id())
@@ -1068,6 +1092,8 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	switch {
+	case errors.Is(err, errSubresourceReadSkipped):
+		// Keep the value in state.
 	case err == nil:
 		if err := d.Set("server_side_encryption_configuration", flattenBucketServerSideEncryptionConfiguration(encryptionConfiguration)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting server_side_encryption_configuration: %s", err)
@@ -1081,9 +1107,9 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	//
 	// Bucket Object Lock Configuration.
 	//
-	objLockConfig, err := retryWhenNoSuchBucketError(ctx, d.Timeout(schema.TimeoutRead), func() (*types.ObjectLockConfiguration, error) {
+	objLockConfig, err := readBucketSubresource(ctx, d, skipUnsetSubresources, func() (*types.ObjectLockConfiguration, error) {
 		return findObjectLockConfiguration(ctx, conn, d.Id(), "")
-	})
+	}, "object_lock_configuration", "object_lock_enabled")
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
 		log.Printf("[WARN] S3 Bucket (%s) not found, removing from state", d.Id())
@@ -1092,6 +1118,8 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	switch {
+	case errors.Is(err, errSubresourceReadSkipped):
+		// Keep the value in state.
 	case err == nil:
 		if err := d.Set("object_lock_configuration", flattenObjectLockConfiguration(objLockConfig)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting object_lock_configuration: %s", err)
@@ -1145,6 +1173,71 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	return diags
 }
 
+// errSubresourceReadSkipped is returned by readBucketSubresource when a sub-resource configuration read is skipped.
+var errSubresourceReadSkipped = errors.New("sub-resource read skipped")
+
+// readBucketSubresource reads a sub-resource configuration, retrying on NoSuchBucket errors.
+// If unset sub-resources are being skipped and none of the specified attributes is set, errSubresourceReadSkipped is returned.
+func readBucketSubresource[T any](ctx context.Context, d *schema.ResourceData, skipUnsetSubresources bool, f func() (T, error), keys ...string) (T, error) {
+	if skipUnsetSubresources && !bucketSubresourceSet(d, keys...) {
+		var zero T
+		return zero, errSubresourceReadSkipped
+	}
+
+	return retryWhenNoSuchBucketError(ctx, d.Timeout(schema.TimeoutRead), f)
+}
+
+// bucketSubresourceSet returns whether any of the specified sub-resource attributes is set.
+// An attribute is set if it is present in configuration or if its value in state differs
+// from the value reported for a bucket on which the sub-resource has never been configured.
+// Terraform does not send configuration when refreshing, in which case only state is used.
+func bucketSubresourceSet(d *schema.ResourceData, keys ...string) bool {
+	if sdkv2.HasNonNullRawValues(d.GetRawConfig(), keys...) {
+		return true
+	}
+
+	for _, key := range keys {
+		if _, ok := d.GetOk(key); ok && !bucketSubresourceHasDefaultValue(d, key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// bucketSubresourceHasDefaultValue returns whether an always-populated sub-resource attribute
+// has the value reported for a bucket on which the sub-resource has never been configured.
+func bucketSubresourceHasDefaultValue(d *schema.ResourceData, key string) bool {
+	switch key {
+	case "grant":
+		// The bucket owner has full control.
+		grants := d.Get(key).(*schema.Set).List()
+		if len(grants) != 1 {
+			return false
+		}
+
+		tfMap, ok := grants[0].(map[string]interface{})
+		if !ok {
+			return false
+		}
+
+		permissions := tfMap[names.AttrPermissions].(*schema.Set)
+
+		return tfMap[names.AttrType].(string) == string(types.TypeCanonicalUser) && permissions.Len() == 1 && permissions.Contains(string(types.PermissionFullControl))
+	case "request_payer":
+		return d.Get(key).(string) == string(types.PayerBucketOwner)
+	case "server_side_encryption_configuration":
+		// Amazon S3 managed keys (SSE-S3) are applied by default.
+		return d.Get(key+".0.rule.0.apply_server_side_encryption_by_default.0.sse_algorithm").(string) == string(types.ServerSideEncryptionAes256) &&
+			d.Get(key+".0.rule.0.apply_server_side_encryption_by_default.0.kms_master_key_id").(string) == "" &&
+			!d.Get(key+".0.rule.0.bucket_key_enabled").(bool)
+	case "versioning":
+		return !d.Get(key+".0.enabled").(bool) && !d.Get(key+".0.mfa_delete").(bool)
+	}
+
+	return false
+}
+
 func resourceBucketUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).S3Client(ctx)
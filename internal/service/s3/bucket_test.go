@@ -2397,6 +2397,145 @@ func TestBucketRegionalDomainName(t *testing.T) {
 	}
 }
 
+func TestBucketSubresourceSet(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		raw      map[string]interface{}
+		keys     []string
+		expected bool
+	}{
+		"policy unset": {
+			raw:  map[string]interface{}{},
+			keys: []string{names.AttrPolicy},
+		},
+		"policy set": {
+			raw: map[string]interface{}{
+				names.AttrPolicy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::test/*"}]}`,
+			},
+			keys:     []string{names.AttrPolicy},
+			expected: true,
+		},
+		"grant owner full control": {
+			raw: map[string]interface{}{
+				"grant": []interface{}{
+					map[string]interface{}{
+						names.AttrID:          "1234567890",
+						names.AttrPermissions: []interface{}{"FULL_CONTROL"},
+						names.AttrType:        "CanonicalUser",
+					},
+				},
+			},
+			keys: []string{"grant"},
+		},
+		"grant group": {
+			raw: map[string]interface{}{
+				"grant": []interface{}{
+					map[string]interface{}{
+						names.AttrPermissions: []interface{}{"READ_ACP", "WRITE"},
+						names.AttrType:        "Group",
+						names.AttrURI:         "http://acs.amazonaws.com/groups/s3/LogDelivery",
+					},
+				},
+			},
+			keys:     []string{"grant"},
+			expected: true,
+		},
+		"request payer bucket owner": {
+			raw: map[string]interface{}{
+				"request_payer": "BucketOwner",
+			},
+			keys: []string{"request_payer"},
+		},
+		"request payer requester": {
+			raw: map[string]interface{}{
+				"request_payer": "Requester",
+			},
+			keys:     []string{"request_payer"},
+			expected: true,
+		},
+		"server side encryption SSE-S3": {
+			raw: map[string]interface{}{
+				"server_side_encryption_configuration": []interface{}{
+					map[string]interface{}{
+						names.AttrRule: []interface{}{
+							map[string]interface{}{
+								"apply_server_side_encryption_by_default": []interface{}{
+									map[string]interface{}{
+										"sse_algorithm": "AES256",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			keys: []string{"server_side_encryption_configuration"},
+		},
+		"server side encryption SSE-KMS": {
+			raw: map[string]interface{}{
+				"server_side_encryption_configuration": []interface{}{
+					map[string]interface{}{
+						names.AttrRule: []interface{}{
+							map[string]interface{}{
+								"apply_server_side_encryption_by_default": []interface{}{
+									map[string]interface{}{
+										"sse_algorithm": "aws:kms",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			keys:     []string{"server_side_encryption_configuration"},
+			expected: true,
+		},
+		"versioning disabled": {
+			raw: map[string]interface{}{
+				"versioning": []interface{}{
+					map[string]interface{}{
+						names.AttrEnabled: false,
+						"mfa_delete":      false,
+					},
+				},
+			},
+			keys: []string{"versioning"},
+		},
+		"versioning enabled": {
+			raw: map[string]interface{}{
+				"versioning": []interface{}{
+					map[string]interface{}{
+						names.AttrEnabled: true,
+						"mfa_delete":      false,
+					},
+				},
+			},
+			keys:     []string{"versioning"},
+			expected: true,
+		},
+		"object lock enabled": {
+			raw: map[string]interface{}{
+				"object_lock_enabled": true,
+			},
+			keys:     []string{"object_lock_configuration", "object_lock_enabled"},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d := schema.TestResourceDataRaw(t, tfs3.ResourceBucket().Schema, testCase.raw)
+
+			if got, want := tfs3.BucketSubresourceSet(d, testCase.keys...), testCase.expected; got != want {
+				t.Errorf("BucketSubresourceSet(%v) = %t, want %t", testCase.keys, got, want)
+			}
+		})
+	}
+}
+
 func TestWebsiteEndpoint(t *testing.T) {
 	t.Parallel()
 
@@ -30,6 +30,7 @@ var (
 
 	BucketUpdateTags                      = bucketUpdateTags
 	BucketRegionalDomainName              = bucketRegionalDomainName
+	BucketSubresourceSet                  = bucketSubresourceSet
 	BucketWebsiteEndpointAndDomain        = bucketWebsiteEndpointAndDomain
 	DeleteAllObjectVersions               = deleteAllObjectVersions
 	EmptyBucket                           = emptyBucket
@@ -5,6 +5,8 @@ package secretsmanager
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"log"
 	"strings"
@@ -48,25 +50,32 @@ func resourceSecretVersion() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"hash_secret_value": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"secret_id": {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
 			"secret_binary": {
-				Type:          schema.TypeString,
-				Optional:      true,
-				ForceNew:      true,
-				Sensitive:     true,
-				ConflictsWith: []string{"secret_string"},
-				ValidateFunc:  verify.ValidBase64String,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Sensitive:        true,
+				ConflictsWith:    []string{"secret_string"},
+				ValidateFunc:     verify.ValidBase64String,
+				DiffSuppressFunc: secretValueHashDiffSuppress,
 			},
 			"secret_string": {
-				Type:          schema.TypeString,
-				Optional:      true,
-				ForceNew:      true,
-				Sensitive:     true,
-				ConflictsWith: []string{"secret_binary"},
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Sensitive:        true,
+				ConflictsWith:    []string{"secret_binary"},
+				DiffSuppressFunc: secretValueHashDiffSuppress,
 			},
 			"version_id": {
 				Type:     schema.TypeString,
@@ -148,9 +157,13 @@ func resourceSecretVersionRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	d.Set(names.AttrARN, output.ARN)
-	d.Set("secret_binary", itypes.Base64EncodeOnce(output.SecretBinary))
+	secretBinary, secretString := itypes.Base64EncodeOnce(output.SecretBinary), aws.ToString(output.SecretString)
+	if d.Get("hash_secret_value").(bool) {
+		secretBinary, secretString = secretValueHash(secretBinary), secretValueHash(secretString)
+	}
+	d.Set("secret_binary", secretBinary)
 	d.Set("secret_id", secretID)
-	d.Set("secret_string", output.SecretString)
+	d.Set("secret_string", secretString)
 	d.Set("version_id", output.VersionId)
 	d.Set("version_stages", output.VersionStages)
 
@@ -303,6 +316,22 @@ func resourceSecretVersionDelete(ctx context.Context, d *schema.ResourceData, me
 	return diags
 }
 
+// secretValueHash returns the value stored in state in place of a secret value when hash_secret_value is set.
+func secretValueHash(v string) string {
+	if v == "" {
+		return ""
+	}
+
+	hash := sha256.Sum256([]byte(v))
+	return hex.EncodeToString(hash[:])
+}
+
+// secretValueHashDiffSuppress suppresses the difference between a hashed secret value in state and the configured value.
+// The check is independent of hash_secret_value so that toggling it off does not force a new version.
+func secretValueHashDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	return old != "" && old == secretValueHash(new)
+}
+
 const secretVersionIDSeparator = "|"
 
 func secretVersionCreateResourceID(secretID, versionID string) string {
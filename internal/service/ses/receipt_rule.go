@@ -343,6 +343,18 @@ func resourceReceiptRuleRead(ctx context.Context, d *schema.ResourceData, meta i
 		Resource:  fmt.Sprintf("receipt-rule-set/%s:receipt-rule/%s", ruleSetName, d.Id()),
 	}.String()
 	d.Set(names.AttrARN, arn)
+
+	// The rule's position is only refreshed when 'after' is configured, so that unordered rules don't
+	// require an additional API call.
+	if d.Get("after").(string) != "" {
+		ruleSet, err := findReceiptRuleSetByName(ctx, conn, ruleSetName)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading SES Receipt Rule Set (%s): %s", ruleSetName, err)
+		}
+
+		d.Set("after", receiptRulePredecessor(ruleSet.Rules, d.Id()))
+	}
 	d.Set(names.AttrEnabled, rule.Enabled)
 	d.Set("recipients", rule.Recipients)
 	d.Set("scan_enabled", rule.ScanEnabled)
@@ -597,6 +609,22 @@ func findReceiptRule(ctx context.Context, conn *ses.Client, input *ses.DescribeR
 	return output.Rule, nil
 }
 
+// receiptRulePredecessor returns the name of the rule immediately preceding the named rule
+// in the rule set's evaluation order, or an empty string if it is the first rule.
+func receiptRulePredecessor(rules []awstypes.ReceiptRule, name string) string {
+	var after string
+
+	for _, rule := range rules {
+		if aws.ToString(rule.Name) == name {
+			return after
+		}
+
+		after = aws.ToString(rule.Name)
+	}
+
+	return ""
+}
+
 func expandReceiptRule(d *schema.ResourceData) *awstypes.ReceiptRule {
 	apiObject := &awstypes.ReceiptRule{
 		Name: aws.String(d.Get(names.AttrName).(string)),
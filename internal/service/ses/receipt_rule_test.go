@@ -45,6 +45,7 @@ func TestAccSESReceiptRule_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
 					resource.TestCheckResourceAttr(resourceName, "rule_set_name", rName),
 					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "ses", fmt.Sprintf("receipt-rule-set/%s:receipt-rule/%s", rName, rName)),
+					resource.TestCheckResourceAttr(resourceName, "after", ""),
 					resource.TestCheckResourceAttr(resourceName, "add_header_action.#", acctest.Ct0),
 					resource.TestCheckResourceAttr(resourceName, "bounce_action.#", acctest.Ct0),
 					resource.TestCheckResourceAttr(resourceName, "lambda_action.#", acctest.Ct0),
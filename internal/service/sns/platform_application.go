@@ -196,23 +196,26 @@ func resourcePlatformApplicationUpdate(ctx context.Context, d *schema.ResourceDa
 	}
 
 	if d.HasChanges("apple_platform_bundle_id", "apple_platform_team_id", "platform_credential", "platform_principal") {
-		// If APNS platform was configured with token-based authentication then the only way to update them
-		// is to update all 4 attributes as they must be specified together in the request.
-		if d.HasChanges("apple_platform_team_id", "apple_platform_bundle_id") {
-			attributes[platformApplicationAttributeNameApplePlatformTeamID] = d.Get("apple_platform_team_id").(string)
-			attributes[platformApplicationAttributeNameApplePlatformBundleID] = d.Get("apple_platform_bundle_id").(string)
-		}
-
 		// Prior to version 3.0.0 of the Terraform AWS Provider, the platform_credential and platform_principal
 		// attributes were stored in state as SHA256 hashes. If the changes to these two attributes are the only
 		// changes and if both of their changes only match updating the state value, then skip the API call.
 		oPCRaw, nPCRaw := d.GetChange("platform_credential")
 		oPPRaw, nPPRaw := d.GetChange("platform_principal")
 
-		if len(attributes) == 0 && isChangeSha256Removal(oPCRaw, nPCRaw) && isChangeSha256Removal(oPPRaw, nPPRaw) {
+		if len(attributes) == 0 && !d.HasChanges("apple_platform_team_id", "apple_platform_bundle_id") && isChangeSha256Removal(oPCRaw, nPCRaw) && isChangeSha256Removal(oPPRaw, nPPRaw) {
 			return diags
 		}
 
+		// If APNS platform was configured with token-based authentication then the only way to update them
+		// is to update all 4 attributes as they must be specified together in the request.
+		// This includes rotating only the signing key (platform_credential).
+		_, teamIDOk := d.GetOk("apple_platform_team_id")
+		_, bundleIDOk := d.GetOk("apple_platform_bundle_id")
+		if d.HasChanges("apple_platform_team_id", "apple_platform_bundle_id") || (teamIDOk && bundleIDOk) {
+			attributes[platformApplicationAttributeNameApplePlatformTeamID] = d.Get("apple_platform_team_id").(string)
+			attributes[platformApplicationAttributeNameApplePlatformBundleID] = d.Get("apple_platform_bundle_id").(string)
+		}
+
 		attributes[platformApplicationAttributeNamePlatformCredential] = d.Get("platform_credential").(string)
 		// If the platform requires a principal it must also be specified, even if it didn't change
 		// since credential is stored as a hash, the only way to update principal is to update both
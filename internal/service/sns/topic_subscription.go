@@ -14,10 +14,12 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/sns"
 	"github.com/aws/aws-sdk-go-v2/service/sns/types"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -168,7 +170,10 @@ func resourceTopicSubscription() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: resourceTopicSubscriptionCustomizeDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceTopicSubscriptionCustomizeDiff,
+			resourceTopicSubscriptionRedrivePolicyCustomizeDiff,
+		),
 
 		Schema: subscriptionSchema,
 	}
@@ -543,6 +548,49 @@ func normalizeTopicSubscriptionDeliveryPolicy(policy string) ([]byte, error) {
 	return b.Bytes(), nil
 }
 
+// resourceTopicSubscriptionRedrivePolicyCustomizeDiff validates that the dead-letter queue in the redrive policy
+// is an SQS queue in the same Region as the topic, and a FIFO queue for FIFO topics.
+// The queue belongs to the subscription owner, which may not be the topic owner, so its account isn't checked.
+func resourceTopicSubscriptionRedrivePolicyCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.HasChange("redrive_policy") || !diff.NewValueKnown(names.AttrTopicARN) {
+		return nil
+	}
+
+	var policy struct {
+		DeadLetterTargetARN string `json:"deadLetterTargetArn"`
+	}
+
+	if err := json.Unmarshal([]byte(diff.Get("redrive_policy").(string)), &policy); err != nil || policy.DeadLetterTargetARN == "" {
+		return nil
+	}
+
+	topicARN, err := arn.Parse(diff.Get(names.AttrTopicARN).(string))
+
+	if err != nil {
+		return nil
+	}
+
+	targetARN, err := arn.Parse(policy.DeadLetterTargetARN)
+
+	if err != nil {
+		return nil
+	}
+
+	if targetARN.Service != names.SQS {
+		return fmt.Errorf("redrive_policy deadLetterTargetArn (%s) must be an SQS queue", policy.DeadLetterTargetARN)
+	}
+
+	if strings.HasSuffix(topicARN.Resource, fifoTopicNameSuffix) && !strings.HasSuffix(targetARN.Resource, fifoTopicNameSuffix) {
+		return fmt.Errorf("redrive_policy deadLetterTargetArn (%s) must be a FIFO queue for a FIFO topic", policy.DeadLetterTargetARN)
+	}
+
+	if targetARN.Region != topicARN.Region {
+		return fmt.Errorf("redrive_policy deadLetterTargetArn (%s) must be in the same Region (%s) as the subscription", policy.DeadLetterTargetARN, topicARN.Region)
+	}
+
+	return nil
+}
+
 func resourceTopicSubscriptionCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
 	hasPolicy := diff.Get("filter_policy").(string) != ""
 	hasScope := !diff.GetRawConfig().GetAttr("filter_policy_scope").IsNull()
@@ -5,6 +5,7 @@ package sqs
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"net/url"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
 	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
@@ -389,6 +391,61 @@ func resourceQueueCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, me
 		return fmt.Errorf("content-based deduplication can only be set for FIFO queue")
 	}
 
+	if diff.HasChange("redrive_policy") {
+		var accountID, region string
+		if v, ok := meta.(*conns.AWSClient); ok {
+			accountID, region = v.AccountID, v.Region
+		}
+
+		if err := validateRedrivePolicyDeadLetterTarget(diff.Get("redrive_policy").(string), fifoQueue, accountID, region); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateRedrivePolicyDeadLetterTarget validates that the dead-letter queue in a redrive policy can be used by the source queue.
+// The dead-letter queue must be of the same type (FIFO or standard) and in the same account and Region as the source queue.
+// Unknown or unparseable policies are left for the API to validate.
+func validateRedrivePolicyDeadLetterTarget(redrivePolicy string, fifoQueue bool, accountID, region string) error {
+	if redrivePolicy == "" {
+		return nil
+	}
+
+	var policy struct {
+		DeadLetterTargetARN string `json:"deadLetterTargetArn"`
+	}
+
+	if err := json.Unmarshal([]byte(redrivePolicy), &policy); err != nil || policy.DeadLetterTargetARN == "" {
+		return nil
+	}
+
+	targetARN, err := arn.Parse(policy.DeadLetterTargetARN)
+
+	if err != nil {
+		return nil
+	}
+
+	if targetARN.Service != names.SQS {
+		return fmt.Errorf("redrive_policy deadLetterTargetArn (%s) must be an SQS queue", policy.DeadLetterTargetARN)
+	}
+
+	switch fifoTarget := strings.HasSuffix(targetARN.Resource, fifoQueueNameSuffix); {
+	case fifoQueue && !fifoTarget:
+		return fmt.Errorf("redrive_policy deadLetterTargetArn (%s) must be a FIFO queue for a FIFO source queue", policy.DeadLetterTargetARN)
+	case !fifoQueue && fifoTarget:
+		return fmt.Errorf("redrive_policy deadLetterTargetArn (%s) must be a standard queue for a standard source queue", policy.DeadLetterTargetARN)
+	}
+
+	if accountID != "" && targetARN.AccountID != accountID {
+		return fmt.Errorf("redrive_policy deadLetterTargetArn (%s) must be in the same account (%s) as the source queue", policy.DeadLetterTargetARN, accountID)
+	}
+
+	if region != "" && targetARN.Region != region {
+		return fmt.Errorf("redrive_policy deadLetterTargetArn (%s) must be in the same Region (%s) as the source queue", policy.DeadLetterTargetARN, region)
+	}
+
 	return nil
 }
 
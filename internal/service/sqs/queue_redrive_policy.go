@@ -4,10 +4,14 @@
 package sqs
 
 import (
+	"context"
+	"strings"
+
 	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -50,5 +54,31 @@ func resourceQueueRedrivePolicy() *schema.Resource {
 		ReadWithoutTimeout:   h.Read,
 		UpdateWithoutTimeout: h.Upsert,
 		DeleteWithoutTimeout: h.Delete,
+
+		CustomizeDiff: resourceQueueRedrivePolicyCustomizeDiff,
+	}
+}
+
+func resourceQueueRedrivePolicyCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("redrive_policy") || !diff.NewValueKnown("queue_url") {
+		return nil
+	}
+
+	queueURL := diff.Get("queue_url").(string)
+	name, err := queueNameFromURL(queueURL)
+
+	if err != nil {
+		return nil
+	}
+
+	var accountID, region string
+	if v, ok := meta.(*conns.AWSClient); ok {
+		region = v.Region
+	}
+	// http://sqs.us-west-2.amazonaws.com/123456789012/queueName
+	if parts := strings.Split(queueURL, "/"); len(parts) >= 2 {
+		accountID = parts[len(parts)-2]
 	}
+
+	return validateRedrivePolicyDeadLetterTarget(diff.Get("redrive_policy").(string), strings.HasSuffix(name, fifoQueueNameSuffix), accountID, region)
 }
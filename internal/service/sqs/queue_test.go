@@ -92,6 +92,76 @@ func TestQueueNameFromURL(t *testing.T) {
 	}
 }
 
+func TestValidateRedrivePolicyDeadLetterTarget(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name          string
+		RedrivePolicy string
+		FIFOQueue     bool
+		ExpectError   bool
+	}{
+		{
+			Name: "empty policy",
+		},
+		{
+			Name:          "standard to standard",
+			RedrivePolicy: `{"deadLetterTargetArn":"arn:aws:sqs:us-west-2:123456789012:dlq","maxReceiveCount":4}`, //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			Name:          "FIFO to FIFO",
+			RedrivePolicy: `{"deadLetterTargetArn":"arn:aws:sqs:us-west-2:123456789012:dlq.fifo","maxReceiveCount":4}`, //lintignore:AWSAT003,AWSAT005
+			FIFOQueue:     true,
+		},
+		{
+			Name:          "FIFO to standard",
+			RedrivePolicy: `{"deadLetterTargetArn":"arn:aws:sqs:us-west-2:123456789012:dlq","maxReceiveCount":4}`, //lintignore:AWSAT003,AWSAT005
+			FIFOQueue:     true,
+			ExpectError:   true,
+		},
+		{
+			Name:          "standard to FIFO",
+			RedrivePolicy: `{"deadLetterTargetArn":"arn:aws:sqs:us-west-2:123456789012:dlq.fifo","maxReceiveCount":4}`, //lintignore:AWSAT003,AWSAT005
+			ExpectError:   true,
+		},
+		{
+			Name:          "other account",
+			RedrivePolicy: `{"deadLetterTargetArn":"arn:aws:sqs:us-west-2:210987654321:dlq","maxReceiveCount":4}`, //lintignore:AWSAT003,AWSAT005
+			ExpectError:   true,
+		},
+		{
+			Name:          "other Region",
+			RedrivePolicy: `{"deadLetterTargetArn":"arn:aws:sqs:us-east-1:123456789012:dlq","maxReceiveCount":4}`, //lintignore:AWSAT003,AWSAT005
+			ExpectError:   true,
+		},
+		{
+			Name:          "not a queue",
+			RedrivePolicy: `{"deadLetterTargetArn":"arn:aws:sns:us-west-2:123456789012:topic","maxReceiveCount":4}`, //lintignore:AWSAT003,AWSAT005
+			ExpectError:   true,
+		},
+		{
+			Name:          "unparseable ARN",
+			RedrivePolicy: `{"deadLetterTargetArn":"not-an-arn","maxReceiveCount":4}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfsqs.ValidateRedrivePolicyDeadLetterTarget(testCase.RedrivePolicy, testCase.FIFOQueue, "123456789012", "us-west-2") //lintignore:AWSAT003
+
+			if err != nil && !testCase.ExpectError {
+				t.Errorf("got unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.ExpectError {
+				t.Errorf("expected error, but received none")
+			}
+		})
+	}
+}
+
 func TestAccSQSQueue_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var queueAttributes map[types.QueueAttributeName]string
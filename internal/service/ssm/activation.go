@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ssm"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -32,6 +33,7 @@ func resourceActivation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceActivationCreate,
 		ReadWithoutTimeout:   resourceActivationRead,
+		UpdateWithoutTimeout: schema.NoopContext, // Allow recreate_before_expiration_hours update.
 		DeleteWithoutTimeout: resourceActivationDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -69,6 +71,11 @@ func resourceActivation() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"recreate_before_expiration_hours": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			"registration_count": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -82,8 +89,44 @@ func resourceActivation() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			verify.SetTagsDiff,
+			customizeDiffActivationRecreation,
+		),
+	}
+}
+
+// customizeDiffActivationRecreation forces a new activation to be created once the existing
+// activation is within recreate_before_expiration_hours of its expiration date.
+// Activations with an explicitly configured expiration_date are left alone.
+func customizeDiffActivationRecreation(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	v, ok := diff.GetOk("recreate_before_expiration_hours")
+	if !ok {
+		return nil
+	}
+
+	if !diff.GetRawConfig().GetAttr("expiration_date").IsNull() {
+		return nil
 	}
+
+	expirationDate, err := time.Parse(time.RFC3339, diff.Get("expiration_date").(string))
+	if err != nil {
+		return nil
+	}
+
+	if time.Until(expirationDate) > time.Duration(v.(int))*time.Hour {
+		return nil
+	}
+
+	if err := diff.SetNewComputed("expiration_date"); err != nil {
+		return err
+	}
+
+	return diff.ForceNew("expiration_date")
 }
 
 func resourceActivationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -13,6 +13,7 @@ import (
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
@@ -93,6 +94,40 @@ func TestAccSSMActivation_expirationDate(t *testing.T) {
 	})
 }
 
+func TestAccSSMActivation_recreateBeforeExpirationHours(t *testing.T) {
+	ctx := acctest.Context(t)
+	var ssmActivation awstypes.Activation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	roleName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_activation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckActivationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccActivationConfig_recreateBeforeExpirationHours(rName, roleName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckActivationExists(ctx, resourceName, &ssmActivation),
+					resource.TestCheckResourceAttr(resourceName, "recreate_before_expiration_hours", acctest.Ct1),
+				),
+			},
+			{
+				// The default activation expiration is 24 hours, so a 48 hour window forces replacement.
+				Config: testAccActivationConfig_recreateBeforeExpirationHours(rName, roleName, 48),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func TestAccSSMActivation_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var ssmActivation awstypes.Activation
@@ -222,3 +257,15 @@ resource "aws_ssm_activation" "test" {
 }
 `, rName, expirationDate))
 }
+
+func testAccActivationConfig_recreateBeforeExpirationHours(rName, roleName string, hours int) string {
+	return acctest.ConfigCompose(testAccActivationConfig_base(roleName), fmt.Sprintf(`
+resource "aws_ssm_activation" "test" {
+  name                             = %[1]q
+  iam_role                         = aws_iam_role.test.name
+  recreate_before_expiration_hours = %[2]d
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, hours))
+}
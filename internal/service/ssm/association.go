@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -74,6 +75,11 @@ func resourceAssociation() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(1, 50),
 			},
+			"calendar_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"compliance_severity": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -137,9 +143,12 @@ func resourceAssociation() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			names.AttrScheduleExpression: {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringLenBetween(1, 256),
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(regexache.MustCompile(`^(at|cron|rate)\(.+\)$`), "must be an at(), cron() or rate() expression"),
+				),
 			},
 			"sync_compliance": {
 				Type:             schema.TypeString,
@@ -201,6 +210,10 @@ func resourceAssociationCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.AutomationTargetParameterName = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("calendar_names"); ok && v.(*schema.Set).Len() > 0 {
+		input.CalendarNames = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
 	if v, ok := d.GetOk("compliance_severity"); ok {
 		input.ComplianceSeverity = awstypes.AssociationComplianceSeverity(v.(string))
 	}
@@ -287,6 +300,7 @@ func resourceAssociationRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set(names.AttrAssociationID, association.AssociationId)
 	d.Set("association_name", association.AssociationName)
 	d.Set("automation_target_parameter_name", association.AutomationTargetParameterName)
+	d.Set("calendar_names", association.CalendarNames)
 	d.Set("compliance_severity", association.ComplianceSeverity)
 	d.Set("document_version", association.DocumentVersion)
 	d.Set(names.AttrInstanceID, association.InstanceId)
@@ -330,6 +344,11 @@ func resourceAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta
 			input.AutomationTargetParameterName = aws.String(v.(string))
 		}
 
+		if v := d.Get("calendar_names").(*schema.Set); v.Len() > 0 || d.HasChange("calendar_names") {
+			// An empty list removes all calendars from the association.
+			input.CalendarNames = flex.ExpandStringValueEmptySet(v)
+		}
+
 		if v, ok := d.GetOk("compliance_severity"); ok {
 			input.ComplianceSeverity = awstypes.AssociationComplianceSeverity(v.(string))
 		}
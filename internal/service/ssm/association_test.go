@@ -535,6 +535,59 @@ func TestAccSSMAssociation_withScheduleExpression(t *testing.T) {
 	})
 }
 
+func TestAccSSMAssociation_calendarNames(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssociationConfig_calendarNames(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "calendar_names.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "calendar_names.*", "aws_ssm_document.calendar", names.AttrName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAssociationConfig_calendarNames(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "calendar_names.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMAssociation_invalidScheduleExpression(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAssociationConfig_basicTargets(rName, `schedule_expression = "every tuesday"`),
+				ExpectError: regexache.MustCompile(`must be an at\(\), cron\(\) or rate\(\) expression`),
+			},
+		},
+	})
+}
+
 func TestAccSSMAssociation_withComplianceSeverity(t *testing.T) {
 	ctx := acctest.Context(t)
 	assocName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -968,6 +1021,30 @@ resource "aws_ssm_association" "test" {
 `, rName, targetsStr)
 }
 
+func testAccAssociationConfig_calendarNames(rName string, withCalendar bool) string {
+	calendarNames := ""
+	if withCalendar {
+		calendarNames = "calendar_names = [aws_ssm_document.calendar.name]"
+	}
+
+	return acctest.ConfigCompose(testAccAssociationConfig_basicTargets(rName, calendarNames), fmt.Sprintf(`
+resource "aws_ssm_document" "calendar" {
+  name            = "%[1]s-calendar"
+  document_type   = "ChangeCalendar"
+  document_format = "TEXT"
+
+  content = <<DOC
+BEGIN:VCALENDAR
+PRODID:-//AWS//Change Calendar 1.0//EN
+VERSION:2.0
+X-CALENDAR-TYPE:DEFAULT_OPEN
+X-WR-CALDESC:test
+END:VCALENDAR
+DOC
+}
+`, rName))
+}
+
 func testAccAssociationConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 data "aws_availability_zones" "available" {
@@ -459,9 +459,11 @@ func resourceDocumentUpdate(ctx context.Context, d *schema.ResourceData, meta in
 
 		if d.HasChange(names.AttrContent) || !isSchemaVersion1 {
 			input := &ssm.UpdateDocumentInput{
-				Content:         aws.String(d.Get(names.AttrContent).(string)),
-				DocumentFormat:  awstypes.DocumentFormat(d.Get("document_format").(string)),
-				DocumentVersion: aws.String(d.Get("default_version").(string)),
+				Content:        aws.String(d.Get(names.AttrContent).(string)),
+				DocumentFormat: awstypes.DocumentFormat(d.Get("document_format").(string)),
+				// Only the latest version of a document can be updated.
+				// The default version may lag behind if it was changed outside Terraform.
+				DocumentVersion: aws.String("$LATEST"),
 				Name:            aws.String(d.Id()),
 			}
 
@@ -5,18 +5,19 @@ package ssm
 
 // Exports for use in tests only.
 var (
-	ResourceActivation              = resourceActivation
-	ResourceAssociation             = resourceAssociation
-	ResourceDefaultPatchBaseline    = resourceDefaultPatchBaseline
-	ResourceDocument                = resourceDocument
-	ResourceMaintenanceWindow       = resourceMaintenanceWindow
-	ResourceMaintenanceWindowTarget = resourceMaintenanceWindowTarget
-	ResourceMaintenanceWindowTask   = resourceMaintenanceWindowTask
-	ResourceParameter               = resourceParameter
-	ResourcePatchBaseline           = resourcePatchBaseline
-	ResourcePatchGroup              = resourcePatchGroup
-	ResourceResourceDataSync        = resourceResourceDataSync
-	ResourceServiceSetting          = resourceServiceSetting
+	ResourceActivation                = resourceActivation
+	ResourceAssociation               = resourceAssociation
+	ResourceDefaultPatchBaseline      = resourceDefaultPatchBaseline
+	ResourceDocument                  = resourceDocument
+	ResourceMaintenanceWindow         = resourceMaintenanceWindow
+	ResourceMaintenanceWindowTarget   = resourceMaintenanceWindowTarget
+	ResourceMaintenanceWindowTask     = resourceMaintenanceWindowTask
+	ResourceParameter                 = resourceParameter
+	ResourcePatchBaseline             = resourcePatchBaseline
+	ResourcePatchGroup                = resourcePatchGroup
+	ResourceResourceDataSync          = resourceResourceDataSync
+	ResourceServiceSetting            = resourceServiceSetting
+	ResourceSessionManagerPreferences = newSessionManagerPreferencesResource
 
 	FindActivationByID                                 = findActivationByID
 	FindAssociationByID                                = findAssociationByID
@@ -31,4 +32,5 @@ var (
 	FindPatchGroupByTwoPartKey                         = findPatchGroupByTwoPartKey
 	FindResourceDataSyncByName                         = findResourceDataSyncByName
 	FindServiceSettingByID                             = findServiceSettingByID
+	FindSessionManagerPreferencesDocumentByName        = findSessionManagerPreferencesDocumentByName
 )
@@ -5,7 +5,9 @@ package ssm
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -90,6 +92,12 @@ func resourceParameter() *schema.Resource {
 				Optional:   true,
 				Deprecated: "this attribute has been deprecated",
 			},
+			"policies": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"tier": {
@@ -129,7 +137,7 @@ func resourceParameter() *schema.Resource {
 				return awstypes.ParameterTier(old.(string)) == awstypes.ParameterTierAdvanced && awstypes.ParameterTier(new.(string)) == awstypes.ParameterTierStandard
 			}),
 			customdiff.ComputedIf(names.AttrVersion, func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
-				return diff.HasChange(names.AttrValue)
+				return diff.HasChanges(names.AttrValue, "policies")
 			}),
 			customdiff.ComputedIf(names.AttrValue, func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("insecure_value")
@@ -138,11 +146,30 @@ func resourceParameter() *schema.Resource {
 				return diff.HasChange(names.AttrValue)
 			}),
 
+			resourceParameterCustomizeDiff,
 			verify.SetTagsDiff,
 		),
 	}
 }
 
+func resourceParameterCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if v, ok := diff.GetOk("data_type"); ok && v.(string) == "aws:ec2:image" && diff.NewValueKnown(names.AttrType) {
+		if typ := awstypes.ParameterType(diff.Get(names.AttrType).(string)); typ != awstypes.ParameterTypeString {
+			return fmt.Errorf(`"data_type" %q requires "type" %q, got %q`, v.(string), awstypes.ParameterTypeString, typ)
+		}
+	}
+
+	// Parameter policies are only supported for advanced parameters.
+	// When "tier" is not configured the parameter is promoted via Intelligent-Tiering on apply.
+	if v, ok := diff.GetOk("policies"); ok && v.(string) != "" && !diff.GetRawConfig().GetAttr("tier").IsNull() && diff.NewValueKnown("tier") {
+		if tier := awstypes.ParameterTier(diff.Get("tier").(string)); tier == awstypes.ParameterTierStandard {
+			return fmt.Errorf(`"policies" cannot be set when "tier" is %q`, tier)
+		}
+	}
+
+	return nil
+}
+
 func resourceParameterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SSMClient(ctx)
@@ -173,8 +200,15 @@ func resourceParameterCreate(ctx context.Context, d *schema.ResourceData, meta i
 		input.KeyId = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("policies"); ok {
+		input.Policies = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("tier"); ok {
 		input.Tier = awstypes.ParameterTier(v.(string))
+	} else if input.Policies != nil {
+		// Parameter policies require the advanced tier.
+		input.Tier = awstypes.ParameterTierIntelligentTiering
 	}
 
 	// AWS SSM Service only supports PutParameter requests with Tags
@@ -274,6 +308,7 @@ func resourceParameterRead(ctx context.Context, d *schema.ResourceData, meta int
 	d.Set("data_type", detail.DataType)
 	d.Set(names.AttrDescription, detail.Description)
 	d.Set(names.AttrKeyID, detail.KeyId)
+	d.Set("policies", flattenParameterInlinePolicies(detail.Policies))
 	d.Set("tier", detail.Tier)
 
 	return diags
@@ -310,9 +345,21 @@ func resourceParameterUpdate(ctx context.Context, d *schema.ResourceData, meta i
 			input.KeyId = aws.String(d.Get(names.AttrKeyID).(string))
 		}
 
+		if d.HasChange("policies") {
+			if v := d.Get("policies").(string); v != "" {
+				input.Policies = aws.String(v)
+			} else {
+				// An empty policy list removes all policies from the parameter.
+				input.Policies = aws.String("[]")
+			}
+		}
+
 		// Retrieve the value set in the config directly to counteract the DiffSuppressFunc above.
 		if v := d.GetRawConfig().GetAttr("tier"); v.IsKnown() && !v.IsNull() {
 			input.Tier = awstypes.ParameterTier(v.AsString())
+		} else if v := d.Get("policies").(string); v != "" && v != "[]" && input.Tier == awstypes.ParameterTierStandard {
+			// Parameter policies require the advanced tier.
+			input.Tier = awstypes.ParameterTierIntelligentTiering
 		}
 
 		_, err := conn.PutParameter(ctx, input)
@@ -429,3 +476,23 @@ func shouldUpdateParameter(d *schema.ResourceData) bool {
 	// if it is not a new resource, otherwise overwrite should be set to false.
 	return !d.IsNewResource()
 }
+
+func flattenParameterInlinePolicies(apiObjects []awstypes.ParameterInlinePolicy) string {
+	if len(apiObjects) == 0 {
+		return ""
+	}
+
+	var policies []string
+
+	for _, apiObject := range apiObjects {
+		if v := aws.ToString(apiObject.PolicyText); v != "" {
+			policies = append(policies, v)
+		}
+	}
+
+	if len(policies) == 0 {
+		return ""
+	}
+
+	return "[" + strings.Join(policies, ",") + "]"
+}
@@ -393,6 +393,77 @@ func TestAccSSMParameter_Tier_intelligentTieringOnUpdate(t *testing.T) {
 	})
 }
 
+func TestAccSSMParameter_policies(t *testing.T) {
+	ctx := acctest.Context(t)
+	var param awstypes.Parameter
+	rName := fmt.Sprintf("%s_%s", t.Name(), sdkacctest.RandString(10))
+	resourceName := "aws_ssm_parameter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParameterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccParameterConfig_policies(rName, string(awstypes.ParameterTierStandard)),
+				ExpectError: regexache.MustCompile(`"policies" cannot be set`),
+			},
+			{
+				Config: testAccParameterConfig_policies(rName, string(awstypes.ParameterTierAdvanced)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterExists(ctx, resourceName, &param),
+					resource.TestCheckResourceAttrSet(resourceName, "policies"),
+					resource.TestCheckResourceAttr(resourceName, "tier", string(awstypes.ParameterTierAdvanced)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccParameterConfig_tier(rName, string(awstypes.ParameterTierAdvanced)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterExists(ctx, resourceName, &param),
+					resource.TestCheckResourceAttr(resourceName, "policies", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMParameter_policiesNoTier(t *testing.T) {
+	ctx := acctest.Context(t)
+	var param awstypes.Parameter
+	rName := fmt.Sprintf("%s_%s", t.Name(), sdkacctest.RandString(10))
+	resourceName := "aws_ssm_parameter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParameterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterConfig_basic(rName, "String", "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterExists(ctx, resourceName, &param),
+					resource.TestCheckResourceAttr(resourceName, "tier", string(awstypes.ParameterTierStandard)),
+				),
+			},
+			{
+				Config: testAccParameterConfig_policiesNoTier(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterExists(ctx, resourceName, &param),
+					resource.TestCheckResourceAttrSet(resourceName, "policies"),
+					resource.TestCheckResourceAttr(resourceName, "tier", string(awstypes.ParameterTierAdvanced)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSSMParameter_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var param awstypes.Parameter
@@ -1310,6 +1381,45 @@ resource "aws_ssm_parameter" "test" {
 `, rName, tier)
 }
 
+func testAccParameterConfig_policies(rName, tier string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "test" {
+  name  = %[1]q
+  tier  = %[2]q
+  type  = "String"
+  value = "test2"
+
+  policies = jsonencode([{
+    Type    = "NoChangeNotification"
+    Version = "1.0"
+    Attributes = {
+      After = "30"
+      Unit  = "Days"
+    }
+  }])
+}
+`, rName, tier)
+}
+
+func testAccParameterConfig_policiesNoTier(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "test" {
+  name  = %[1]q
+  type  = "String"
+  value = "test2"
+
+  policies = jsonencode([{
+    Type    = "NoChangeNotification"
+    Version = "1.0"
+    Attributes = {
+      After = "30"
+      Unit  = "Days"
+    }
+  }])
+}
+`, rName)
+}
+
 func testAccParameterConfig_tierWithValue(rName, tier, value string) string {
 	return fmt.Sprintf(`
 resource "aws_ssm_parameter" "test" {
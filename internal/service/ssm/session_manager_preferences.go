@@ -0,0 +1,486 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// Session Manager reads its Region-level preferences from this well-known document.
+	sessionManagerPreferencesDocumentName = "SSM-SessionManagerRunShell"
+
+	sessionManagerPreferencesDefaultIdleSessionTimeout = 20
+)
+
+// @FrameworkResource("aws_ssm_session_manager_preferences", name="Session Manager Preferences")
+func newSessionManagerPreferencesResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &sessionManagerPreferencesResource{}
+
+	return r, nil
+}
+
+type sessionManagerPreferencesResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*sessionManagerPreferencesResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_ssm_session_manager_preferences"
+}
+
+func (r *sessionManagerPreferencesResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cloudwatch_encryption_enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"cloudwatch_log_group_name": schema.StringAttribute{
+				Optional: true,
+			},
+			"cloudwatch_streaming_enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			names.AttrID: framework.IDAttribute(),
+			"idle_session_timeout": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(sessionManagerPreferencesDefaultIdleSessionTimeout),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 60),
+				},
+			},
+			names.AttrKMSKeyID: schema.StringAttribute{
+				Optional: true,
+			},
+			"max_session_duration": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 1440),
+				},
+			},
+			"run_as_default_user": schema.StringAttribute{
+				Optional: true,
+			},
+			"run_as_enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrS3BucketName: schema.StringAttribute{
+				Optional: true,
+			},
+			"s3_encryption_enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			names.AttrS3KeyPrefix: schema.StringAttribute{
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"shell_profile": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[sessionManagerShellProfileModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"linux": schema.StringAttribute{
+							Optional: true,
+						},
+						"windows": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *sessionManagerPreferencesResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data sessionManagerPreferencesResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSMClient(ctx)
+
+	content, diags := data.content(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	name := sessionManagerPreferencesDocumentName
+	input := &ssm.CreateDocumentInput{
+		Content:        aws.String(content),
+		DocumentFormat: awstypes.DocumentFormatJson,
+		DocumentType:   awstypes.DocumentTypeSession,
+		Name:           aws.String(name),
+	}
+
+	_, err := conn.CreateDocument(ctx, input)
+
+	// The preferences document is created the first time preferences are saved in the console.
+	// Adopt an existing document rather than failing.
+	if errs.IsA[*awstypes.DocumentAlreadyExists](err) {
+		err = updateSessionManagerPreferencesDocument(ctx, conn, name, content)
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating SSM Session Manager Preferences (%s)", name), err.Error())
+
+		return
+	}
+
+	if _, err := waitDocumentActive(ctx, conn, name); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for SSM Session Manager Preferences (%s) create", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringValueToFramework(ctx, name)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *sessionManagerPreferencesResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data sessionManagerPreferencesResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSMClient(ctx)
+
+	document, err := findSessionManagerPreferencesDocumentByName(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSM Session Manager Preferences (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.setContent(ctx, document)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *sessionManagerPreferencesResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new sessionManagerPreferencesResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSMClient(ctx)
+
+	content, diags := new.content(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	name := new.ID.ValueString()
+	if err := updateSessionManagerPreferencesDocument(ctx, conn, name, content); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating SSM Session Manager Preferences (%s)", name), err.Error())
+
+		return
+	}
+
+	if _, err := waitDocumentActive(ctx, conn, name); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for SSM Session Manager Preferences (%s) update", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *sessionManagerPreferencesResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data sessionManagerPreferencesResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSMClient(ctx)
+
+	// Deleting the preferences document reverts Session Manager to its default preferences.
+	name := data.ID.ValueString()
+	_, err := conn.DeleteDocument(ctx, &ssm.DeleteDocumentInput{
+		Name: aws.String(name),
+	})
+
+	if errs.IsAErrorMessageContains[*awstypes.InvalidDocument](err, "does not exist") {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting SSM Session Manager Preferences (%s)", name), err.Error())
+
+		return
+	}
+
+	if _, err := waitDocumentDeleted(ctx, conn, name); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for SSM Session Manager Preferences (%s) delete", name), err.Error())
+
+		return
+	}
+}
+
+func updateSessionManagerPreferencesDocument(ctx context.Context, conn *ssm.Client, name, content string) error {
+	input := &ssm.UpdateDocumentInput{
+		Content:         aws.String(content),
+		DocumentFormat:  awstypes.DocumentFormatJson,
+		DocumentVersion: aws.String("$LATEST"),
+		Name:            aws.String(name),
+	}
+
+	output, err := conn.UpdateDocument(ctx, input)
+
+	if errs.IsA[*awstypes.DuplicateDocumentContent](err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.UpdateDocumentDefaultVersion(ctx, &ssm.UpdateDocumentDefaultVersionInput{
+		DocumentVersion: output.DocumentDescription.DocumentVersion,
+		Name:            aws.String(name),
+	})
+
+	return err
+}
+
+func findSessionManagerPreferencesDocumentByName(ctx context.Context, conn *ssm.Client, name string) (*sessionManagerPreferencesDocument, error) {
+	input := &ssm.GetDocumentInput{
+		DocumentFormat: awstypes.DocumentFormatJson,
+		Name:           aws.String(name),
+	}
+
+	output, err := conn.GetDocument(ctx, input)
+
+	if errs.IsAErrorMessageContains[*awstypes.InvalidDocument](err, "does not exist") {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Content == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if output.DocumentType != awstypes.DocumentTypeSession {
+		return nil, fmt.Errorf("unexpected SSM Document (%s) type: %s", name, output.DocumentType)
+	}
+
+	var document sessionManagerPreferencesDocument
+	if err := json.Unmarshal([]byte(aws.ToString(output.Content)), &document); err != nil {
+		return nil, fmt.Errorf("decoding SSM Document (%s) content: %w", name, err)
+	}
+
+	return &document, nil
+}
+
+type sessionManagerPreferencesResourceModel struct {
+	CloudWatchEncryptionEnabled types.Bool                                                       `tfsdk:"cloudwatch_encryption_enabled"`
+	CloudWatchLogGroupName      types.String                                                     `tfsdk:"cloudwatch_log_group_name"`
+	CloudWatchStreamingEnabled  types.Bool                                                       `tfsdk:"cloudwatch_streaming_enabled"`
+	ID                          types.String                                                     `tfsdk:"id"`
+	IdleSessionTimeout          types.Int64                                                      `tfsdk:"idle_session_timeout"`
+	KMSKeyID                    types.String                                                     `tfsdk:"kms_key_id"`
+	MaxSessionDuration          types.Int64                                                      `tfsdk:"max_session_duration"`
+	RunAsDefaultUser            types.String                                                     `tfsdk:"run_as_default_user"`
+	RunAsEnabled                types.Bool                                                       `tfsdk:"run_as_enabled"`
+	S3BucketName                types.String                                                     `tfsdk:"s3_bucket_name"`
+	S3EncryptionEnabled         types.Bool                                                       `tfsdk:"s3_encryption_enabled"`
+	S3KeyPrefix                 types.String                                                     `tfsdk:"s3_key_prefix"`
+	ShellProfile                fwtypes.ListNestedObjectValueOf[sessionManagerShellProfileModel] `tfsdk:"shell_profile"`
+}
+
+type sessionManagerShellProfileModel struct {
+	Linux   types.String `tfsdk:"linux"`
+	Windows types.String `tfsdk:"windows"`
+}
+
+func (data *sessionManagerPreferencesResourceModel) content(ctx context.Context) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	inputs := sessionManagerPreferencesInputs{
+		CloudWatchEncryptionEnabled: data.CloudWatchEncryptionEnabled.ValueBool(),
+		CloudWatchLogGroupName:      data.CloudWatchLogGroupName.ValueString(),
+		CloudWatchStreamingEnabled:  data.CloudWatchStreamingEnabled.ValueBool(),
+		KMSKeyID:                    data.KMSKeyID.ValueString(),
+		RunAsDefaultUser:            data.RunAsDefaultUser.ValueString(),
+		RunAsEnabled:                data.RunAsEnabled.ValueBool(),
+		S3BucketName:                data.S3BucketName.ValueString(),
+		S3EncryptionEnabled:         data.S3EncryptionEnabled.ValueBool(),
+		S3KeyPrefix:                 data.S3KeyPrefix.ValueString(),
+	}
+
+	if !data.IdleSessionTimeout.IsNull() {
+		inputs.IdleSessionTimeout = strconv.FormatInt(data.IdleSessionTimeout.ValueInt64(), 10)
+	}
+
+	if !data.MaxSessionDuration.IsNull() {
+		inputs.MaxSessionDuration = strconv.FormatInt(data.MaxSessionDuration.ValueInt64(), 10)
+	}
+
+	shellProfile, d := data.ShellProfile.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	if shellProfile != nil {
+		inputs.ShellProfile = &sessionManagerPreferencesShellProfile{
+			Linux:   shellProfile.Linux.ValueString(),
+			Windows: shellProfile.Windows.ValueString(),
+		}
+	}
+
+	document := sessionManagerPreferencesDocument{
+		Description:   "Document to hold regional settings for Session Manager",
+		Inputs:        inputs,
+		SchemaVersion: "1.0",
+		SessionType:   "Standard_Stream",
+	}
+
+	b, err := json.Marshal(document)
+
+	if err != nil {
+		diags.AddError("encoding SSM Session Manager Preferences", err.Error())
+
+		return "", diags
+	}
+
+	return string(b), diags
+}
+
+func (data *sessionManagerPreferencesResourceModel) setContent(ctx context.Context, document *sessionManagerPreferencesDocument) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	inputs := document.Inputs
+
+	data.CloudWatchEncryptionEnabled = types.BoolValue(inputs.CloudWatchEncryptionEnabled)
+	data.CloudWatchLogGroupName = fwflex.StringValueToFramework(ctx, inputs.CloudWatchLogGroupName)
+	data.CloudWatchStreamingEnabled = types.BoolValue(inputs.CloudWatchStreamingEnabled)
+	data.KMSKeyID = fwflex.StringValueToFramework(ctx, inputs.KMSKeyID)
+	data.RunAsDefaultUser = fwflex.StringValueToFramework(ctx, inputs.RunAsDefaultUser)
+	data.RunAsEnabled = types.BoolValue(inputs.RunAsEnabled)
+	data.S3BucketName = fwflex.StringValueToFramework(ctx, inputs.S3BucketName)
+	data.S3EncryptionEnabled = types.BoolValue(inputs.S3EncryptionEnabled)
+	data.S3KeyPrefix = fwflex.StringValueToFramework(ctx, inputs.S3KeyPrefix)
+
+	data.IdleSessionTimeout = types.Int64Value(sessionManagerPreferencesDefaultIdleSessionTimeout)
+	if v := inputs.IdleSessionTimeout; v != "" {
+		i, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			diags.AddError("parsing SSM Session Manager Preferences idleSessionTimeout", err.Error())
+
+			return diags
+		}
+		data.IdleSessionTimeout = types.Int64Value(i)
+	}
+
+	data.MaxSessionDuration = types.Int64Null()
+	if v := inputs.MaxSessionDuration; v != "" {
+		i, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			diags.AddError("parsing SSM Session Manager Preferences maxSessionDuration", err.Error())
+
+			return diags
+		}
+		data.MaxSessionDuration = types.Int64Value(i)
+	}
+
+	if v := inputs.ShellProfile; v != nil && (v.Linux != "" || v.Windows != "") {
+		data.ShellProfile = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &sessionManagerShellProfileModel{
+			Linux:   fwflex.StringValueToFramework(ctx, v.Linux),
+			Windows: fwflex.StringValueToFramework(ctx, v.Windows),
+		})
+	} else {
+		data.ShellProfile = fwtypes.NewListNestedObjectValueOfNull[sessionManagerShellProfileModel](ctx)
+	}
+
+	return diags
+}
+
+type sessionManagerPreferencesDocument struct {
+	Description   string                          `json:"description"`
+	Inputs        sessionManagerPreferencesInputs `json:"inputs"`
+	SchemaVersion string                          `json:"schemaVersion"`
+	SessionType   string                          `json:"sessionType"`
+}
+
+type sessionManagerPreferencesInputs struct {
+	CloudWatchEncryptionEnabled bool                                   `json:"cloudWatchEncryptionEnabled"`
+	CloudWatchLogGroupName      string                                 `json:"cloudWatchLogGroupName"`
+	CloudWatchStreamingEnabled  bool                                   `json:"cloudWatchStreamingEnabled"`
+	IdleSessionTimeout          string                                 `json:"idleSessionTimeout,omitempty"`
+	KMSKeyID                    string                                 `json:"kmsKeyId"`
+	MaxSessionDuration          string                                 `json:"maxSessionDuration,omitempty"`
+	RunAsDefaultUser            string                                 `json:"runAsDefaultUser"`
+	RunAsEnabled                bool                                   `json:"runAsEnabled"`
+	S3BucketName                string                                 `json:"s3BucketName"`
+	S3EncryptionEnabled         bool                                   `json:"s3EncryptionEnabled"`
+	S3KeyPrefix                 string                                 `json:"s3KeyPrefix"`
+	ShellProfile                *sessionManagerPreferencesShellProfile `json:"shellProfile,omitempty"`
+}
+
+type sessionManagerPreferencesShellProfile struct {
+	Linux   string `json:"linux"`
+	Windows string `json:"windows"`
+}
@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccSSMSessionManagerPreferences_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ssm_session_manager_preferences.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSessionManagerPreferencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSessionManagerPreferencesConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSessionManagerPreferencesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_encryption_enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_streaming_enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, names.AttrID, "SSM-SessionManagerRunShell"),
+					resource.TestCheckResourceAttr(resourceName, "idle_session_timeout", "20"),
+					resource.TestCheckNoResourceAttr(resourceName, "max_session_duration"),
+					resource.TestCheckResourceAttr(resourceName, "run_as_enabled", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "s3_encryption_enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "shell_profile.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSSMSessionManagerPreferences_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ssm_session_manager_preferences.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSessionManagerPreferencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSessionManagerPreferencesConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSessionManagerPreferencesExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfssm.ResourceSessionManagerPreferences, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccSSMSessionManagerPreferences_logging(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_session_manager_preferences.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSessionManagerPreferencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSessionManagerPreferencesConfig_logging(rName, 15),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSessionManagerPreferencesExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "cloudwatch_log_group_name", "aws_cloudwatch_log_group.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "idle_session_timeout", "15"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrKMSKeyID, "aws_kms_key.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "max_session_duration", "120"),
+					resource.TestCheckResourceAttr(resourceName, "run_as_default_user", "ssm-user"),
+					resource.TestCheckResourceAttr(resourceName, "run_as_enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrS3BucketName, "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, names.AttrS3KeyPrefix, "sessions/"),
+					resource.TestCheckResourceAttr(resourceName, "shell_profile.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "shell_profile.0.linux", "exec bash"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSessionManagerPreferencesConfig_logging(rName, 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSessionManagerPreferencesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "idle_session_timeout", "30"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSessionManagerPreferencesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssm_session_manager_preferences" {
+				continue
+			}
+
+			_, err := tfssm.FindSessionManagerPreferencesDocumentByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SSM Session Manager Preferences %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSessionManagerPreferencesExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		_, err := tfssm.FindSessionManagerPreferencesDocumentByName(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccSessionManagerPreferencesConfig_basic() string {
+	return `
+resource "aws_ssm_session_manager_preferences" "test" {}
+`
+}
+
+func testAccSessionManagerPreferencesConfig_logging(rName string, idleSessionTimeout int) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+}
+
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_ssm_session_manager_preferences" "test" {
+  cloudwatch_encryption_enabled = false
+  cloudwatch_log_group_name     = aws_cloudwatch_log_group.test.name
+  idle_session_timeout          = %[2]d
+  kms_key_id                    = aws_kms_key.test.arn
+  max_session_duration          = 120
+  run_as_default_user           = "ssm-user"
+  run_as_enabled                = true
+  s3_bucket_name                = aws_s3_bucket.test.bucket
+  s3_encryption_enabled         = false
+  s3_key_prefix                 = "sessions/"
+
+  shell_profile {
+    linux = "exec bash"
+  }
+}
+`, rName, idleSessionTimeout)
+}
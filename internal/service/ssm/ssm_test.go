@@ -28,6 +28,11 @@ func TestAccSSM_serial(t *testing.T) {
 		"PatchBaseline": {
 			"deleteDefault": testAccSSMPatchBaseline_deleteDefault,
 		},
+		"SessionManagerPreferences": {
+			acctest.CtBasic:      testAccSSMSessionManagerPreferences_basic,
+			acctest.CtDisappears: testAccSSMSessionManagerPreferences_disappears,
+			"logging":            testAccSSMSessionManagerPreferences_logging,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
@@ -20,4 +20,5 @@ var (
 	FindWebACLByThreePartKey          = findWebACLByThreePartKey
 	ListRuleGroupsPages               = listRuleGroupsPages
 	ListWebACLsPages                  = listWebACLsPages
+	ValidWebACLAssociationARNs        = validWebACLAssociationARNs
 )
@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/wafv2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/wafv2/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -60,7 +61,10 @@ func resourceIPSet() *schema.Resource {
 					Type:     schema.TypeSet,
 					Optional: true,
 					MaxItems: 10000,
-					Elem:     &schema.Schema{Type: schema.TypeString},
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.IsCIDR,
+					},
 					DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 						if d.GetRawPlan().GetAttr("addresses").IsWhollyKnown() {
 							o, n := d.GetChange("addresses")
@@ -121,8 +125,34 @@ func resourceIPSet() *schema.Resource {
 			}
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			ipSetAddressesMatchIPAddressVersion,
+		),
+	}
+}
+
+// ipSetAddressesMatchIPAddressVersion validates at plan time that every address is of the IP set's IP address version.
+func ipSetAddressesMatchIPAddressVersion(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.GetRawPlan().GetAttr("addresses").IsWhollyKnown() {
+		return nil
+	}
+
+	ipAddressVersion := awstypes.IPAddressVersion(d.Get("ip_address_version").(string))
+
+	for _, v := range d.Get("addresses").(*schema.Set).List() {
+		address := v.(string)
+		isIPv6 := strings.Contains(address, ":")
+
+		switch {
+		case ipAddressVersion == awstypes.IPAddressVersionIpv4 && isIPv6:
+			return fmt.Errorf("addresses: %q is not an IPv4 CIDR block but ip_address_version is %s", address, ipAddressVersion)
+		case ipAddressVersion == awstypes.IPAddressVersionIpv6 && !isIPv6:
+			return fmt.Errorf("addresses: %q is not an IPv6 CIDR block but ip_address_version is %s", address, ipAddressVersion)
+		}
 	}
+
+	return nil
 }
 
 func resourceIPSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -228,6 +228,32 @@ func TestAccWAFV2IPSet_addresses(t *testing.T) {
 	})
 }
 
+func TestAccWAFV2IPSet_invalidAddresses(t *testing.T) {
+	ctx := acctest.Context(t)
+	ipSetName := fmt.Sprintf("ip-set-%s", sdkacctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckScopeRegional(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WAFV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIPSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccIPSetConfig_singleAddress(ipSetName, "IPV4", "1.2.3.4"),
+				ExpectError: regexache.MustCompile(`invalid CIDR address`),
+			},
+			{
+				Config:      testAccIPSetConfig_singleAddress(ipSetName, "IPV4", "2001:db8::/32"),
+				ExpectError: regexache.MustCompile(`is not an IPv4 CIDR block`),
+			},
+			{
+				Config:      testAccIPSetConfig_singleAddress(ipSetName, "IPV6", "1.2.3.4/32"),
+				ExpectError: regexache.MustCompile(`is not an IPv6 CIDR block`),
+			},
+		},
+	})
+}
+
 func TestAccWAFV2IPSet_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v awstypes.IPSet
@@ -380,6 +406,17 @@ resource "aws_wafv2_ip_set" "ip_set" {
 `, name)
 }
 
+func testAccIPSetConfig_singleAddress(name, ipAddressVersion, address string) string {
+	return fmt.Sprintf(`
+resource "aws_wafv2_ip_set" "ip_set" {
+  name               = %[1]q
+  scope              = "REGIONAL"
+  ip_address_version = %[2]q
+  addresses          = [%[3]q]
+}
+`, name, ipAddressVersion, address)
+}
+
 func testAccIPSetConfig_addresses(name string) string {
 	return fmt.Sprintf(`
 resource "aws_eip" "test" {
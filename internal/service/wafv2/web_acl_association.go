@@ -5,10 +5,13 @@ package wafv2
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/wafv2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/wafv2/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -58,7 +61,60 @@ func resourceWebACLAssociation() *schema.Resource {
 				},
 			}
 		},
+
+		CustomizeDiff: resourceWebACLAssociationCustomizeDiff,
+	}
+}
+
+func resourceWebACLAssociationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("web_acl_arn") || !d.NewValueKnown(names.AttrResourceARN) {
+		return nil
+	}
+
+	return validWebACLAssociationARNs(d.Get("web_acl_arn").(string), d.Get(names.AttrResourceARN).(string))
+}
+
+// validWebACLAssociationARNs checks that the resource ARN is of a type that can be associated with a regional web ACL.
+func validWebACLAssociationARNs(webACLARN, resourceARN string) error {
+	webACL, err := arn.Parse(webACLARN)
+	if err != nil {
+		return fmt.Errorf("parsing web_acl_arn (%s): %w", webACLARN, err)
+	}
+
+	if strings.HasPrefix(webACL.Resource, "global/") {
+		return fmt.Errorf("web ACL (%s) has CLOUDFRONT scope; associate it using the web_acl_id argument of aws_cloudfront_distribution", webACLARN)
 	}
+
+	resource, err := arn.Parse(resourceARN)
+	if err != nil {
+		return fmt.Errorf("parsing resource_arn (%s): %w", resourceARN, err)
+	}
+
+	var supported bool
+	switch resource.Service {
+	case "apigateway":
+		supported = strings.HasPrefix(resource.Resource, "/restapis/")
+	case "apprunner":
+		supported = strings.HasPrefix(resource.Resource, "service/")
+	case "appsync":
+		supported = strings.HasPrefix(resource.Resource, "apis/")
+	case "cognito-idp":
+		supported = strings.HasPrefix(resource.Resource, "userpool/")
+	case "ec2":
+		supported = strings.HasPrefix(resource.Resource, "verified-access-instance/")
+	case "elasticloadbalancing":
+		supported = strings.HasPrefix(resource.Resource, "loadbalancer/app/")
+	}
+
+	if !supported {
+		return fmt.Errorf("resource (%s) cannot be associated with a web ACL; supported resources are Application Load Balancers, API Gateway REST API stages, AppSync GraphQL APIs, Cognito user pools, App Runner services and Verified Access instances", resourceARN)
+	}
+
+	if webACL.Region != resource.Region {
+		return fmt.Errorf("web ACL (%s) and resource (%s) must be in the same Region", webACLARN, resourceARN)
+	}
+
+	return nil
 }
 
 func resourceWebACLAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -19,6 +19,78 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestValidWebACLAssociationARNs(t *testing.T) {
+	t.Parallel()
+
+	const (
+		regionalWebACLARN = "arn:aws:wafv2:us-west-2:123456789012:regional/webacl/test/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111" //lintignore:AWSAT003,AWSAT005
+		globalWebACLARN   = "arn:aws:wafv2:us-east-1:123456789012:global/webacl/test/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111"   //lintignore:AWSAT003,AWSAT005
+	)
+
+	testCases := map[string]struct {
+		webACLARN   string
+		resourceARN string
+		expectError bool
+	}{
+		"application load balancer": {
+			webACLARN:   regionalWebACLARN,
+			resourceARN: "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/test/50dc6c495c0c9188", //lintignore:AWSAT003,AWSAT005
+		},
+		"network load balancer": {
+			webACLARN:   regionalWebACLARN,
+			resourceARN: "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/net/test/50dc6c495c0c9188", //lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+		"api gateway stage": {
+			webACLARN:   regionalWebACLARN,
+			resourceARN: "arn:aws:apigateway:us-west-2::/restapis/a1b2c3d4e5/stages/test", //lintignore:AWSAT003,AWSAT005
+		},
+		"app runner service": {
+			webACLARN:   regionalWebACLARN,
+			resourceARN: "arn:aws:apprunner:us-west-2:123456789012:service/test/8fe1e10304f84fd2b0df550fe98a71fa", //lintignore:AWSAT003,AWSAT005
+		},
+		"appsync api": {
+			webACLARN:   regionalWebACLARN,
+			resourceARN: "arn:aws:appsync:us-west-2:123456789012:apis/a1b2c3d4e5f6g7h8", //lintignore:AWSAT003,AWSAT005
+		},
+		"cognito user pool": {
+			webACLARN:   regionalWebACLARN,
+			resourceARN: "arn:aws:cognito-idp:us-west-2:123456789012:userpool/us-west-2_EXAMPLE", //lintignore:AWSAT003,AWSAT005
+		},
+		"verified access instance": {
+			webACLARN:   regionalWebACLARN,
+			resourceARN: "arn:aws:ec2:us-west-2:123456789012:verified-access-instance/vai-0ce000c0b7643abea", //lintignore:AWSAT003,AWSAT005
+		},
+		"ec2 instance": {
+			webACLARN:   regionalWebACLARN,
+			resourceARN: "arn:aws:ec2:us-west-2:123456789012:instance/i-1234567890abcdef0", //lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+		"different region": {
+			webACLARN:   regionalWebACLARN,
+			resourceARN: "arn:aws:cognito-idp:us-east-1:123456789012:userpool/us-east-1_EXAMPLE", //lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+		"cloudfront web acl": {
+			webACLARN:   globalWebACLARN,
+			resourceARN: "arn:aws:cognito-idp:us-east-1:123456789012:userpool/us-east-1_EXAMPLE", //lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfwafv2.ValidWebACLAssociationARNs(testCase.webACLARN, testCase.resourceARN)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("ValidWebACLAssociationARNs(%q, %q) error = %v, expectError = %t", testCase.webACLARN, testCase.resourceARN, err, want)
+			}
+		})
+	}
+}
+
 func TestAccWAFV2WebACLAssociation_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
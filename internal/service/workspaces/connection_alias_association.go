@@ -0,0 +1,318 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workspaces"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspaces/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var ResourceConnectionAliasAssociation = newResourceConnectionAliasAssociation
+
+// @FrameworkResource(name="Connection Alias Association")
+func newResourceConnectionAliasAssociation(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceConnectionAliasAssociation{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameConnectionAliasAssociation = "Connection Alias Association"
+
+	connectionAliasAssociationResourceIDPartCount = 2
+)
+
+type resourceConnectionAliasAssociation struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithTimeouts
+}
+
+func (r *resourceConnectionAliasAssociation) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_workspaces_connection_alias_association"
+}
+
+func (r *resourceConnectionAliasAssociation) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"alias_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "The identifier of the connection alias.",
+			},
+			"associated_account_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The identifier of the Amazon Web Services account that associated the connection alias with a directory.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"connection_identifier": schema.StringAttribute{
+				Computed:    true,
+				Description: "The identifier of the connection alias association, used to configure DNS routing.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrResourceID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "The identifier of the directory to associate the connection alias with.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceConnectionAliasAssociation) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().WorkSpacesClient(ctx)
+
+	var plan resourceConnectionAliasAssociationData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	aliasID, resourceID := plan.AliasID.ValueString(), plan.ResourceID.ValueString()
+	id, err := flex.FlattenResourceId([]string{aliasID, resourceID}, connectionAliasAssociationResourceIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.WorkSpaces, create.ErrActionCreating, ResNameConnectionAliasAssociation, aliasID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	in := &workspaces.AssociateConnectionAliasInput{
+		AliasId:    aws.String(aliasID),
+		ResourceId: aws.String(resourceID),
+	}
+
+	_, err = conn.AssociateConnectionAlias(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.WorkSpaces, create.ErrActionCreating, ResNameConnectionAliasAssociation, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(id)
+
+	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
+	association, err := waitConnectionAliasAssociated(ctx, conn, aliasID, resourceID, createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.WorkSpaces, create.ErrActionWaitingForCreation, ResNameConnectionAliasAssociation, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.update(ctx, association)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceConnectionAliasAssociation) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().WorkSpacesClient(ctx)
+
+	var state resourceConnectionAliasAssociationData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parts, err := flex.ExpandResourceId(state.ID.ValueString(), connectionAliasAssociationResourceIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.WorkSpaces, create.ErrActionSetting, ResNameConnectionAliasAssociation, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	out, err := FindConnectionAliasAssociationByTwoPartKey(ctx, conn, parts[0], parts[1])
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.WorkSpaces, create.ErrActionSetting, ResNameConnectionAliasAssociation, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.AliasID = types.StringValue(parts[0])
+	state.update(ctx, out)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceConnectionAliasAssociation) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().WorkSpacesClient(ctx)
+
+	var state resourceConnectionAliasAssociationData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &workspaces.DisassociateConnectionAliasInput{
+		AliasId: state.AliasID.ValueStringPointer(),
+	}
+
+	_, err := conn.DisassociateConnectionAlias(ctx, in)
+	if err != nil {
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.WorkSpaces, create.ErrActionDeleting, ResNameConnectionAliasAssociation, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
+	_, err = waitConnectionAliasDisassociated(ctx, conn, state.AliasID.ValueString(), state.ResourceID.ValueString(), deleteTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.WorkSpaces, create.ErrActionWaitingForDeletion, ResNameConnectionAliasAssociation, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceConnectionAliasAssociation) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
+}
+
+func (data *resourceConnectionAliasAssociationData) update(ctx context.Context, in *awstypes.ConnectionAliasAssociation) {
+	data.AssociatedAccountID = fwflex.StringToFramework(ctx, in.AssociatedAccountId)
+	data.ConnectionIdentifier = fwflex.StringToFramework(ctx, in.ConnectionIdentifier)
+	data.ResourceID = fwflex.StringToFramework(ctx, in.ResourceId)
+}
+
+func waitConnectionAliasAssociated(ctx context.Context, conn *workspaces.Client, aliasID, resourceID string, timeout time.Duration) (*awstypes.ConnectionAliasAssociation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AssociationStatusPendingAssociation),
+		Target: enum.Slice(
+			awstypes.AssociationStatusAssociatedWithOwnerAccount,
+			awstypes.AssociationStatusAssociatedWithSharedAccount,
+		),
+		Refresh:                   statusConnectionAliasAssociation(ctx, conn, aliasID, resourceID),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*awstypes.ConnectionAliasAssociation); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitConnectionAliasDisassociated(ctx context.Context, conn *workspaces.Client, aliasID, resourceID string, timeout time.Duration) (*awstypes.ConnectionAliasAssociation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(
+			awstypes.AssociationStatusAssociatedWithOwnerAccount,
+			awstypes.AssociationStatusAssociatedWithSharedAccount,
+			awstypes.AssociationStatusPendingDisassociation,
+		),
+		Target:  []string{},
+		Refresh: statusConnectionAliasAssociation(ctx, conn, aliasID, resourceID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*awstypes.ConnectionAliasAssociation); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func statusConnectionAliasAssociation(ctx context.Context, conn *workspaces.Client, aliasID, resourceID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := FindConnectionAliasAssociationByTwoPartKey(ctx, conn, aliasID, resourceID)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.AssociationStatus), nil
+	}
+}
+
+func FindConnectionAliasAssociationByTwoPartKey(ctx context.Context, conn *workspaces.Client, aliasID, resourceID string) (*awstypes.ConnectionAliasAssociation, error) {
+	alias, err := FindConnectionAliasByID(ctx, conn, aliasID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range alias.Associations {
+		if aws.ToString(v.ResourceId) != resourceID {
+			continue
+		}
+
+		if v.AssociationStatus == awstypes.AssociationStatusNotAssociated {
+			continue
+		}
+
+		return &v, nil
+	}
+
+	return nil, &retry.NotFoundError{}
+}
+
+type resourceConnectionAliasAssociationData struct {
+	AliasID              types.String   `tfsdk:"alias_id"`
+	AssociatedAccountID  types.String   `tfsdk:"associated_account_id"`
+	ConnectionIdentifier types.String   `tfsdk:"connection_identifier"`
+	ID                   types.String   `tfsdk:"id"`
+	ResourceID           types.String   `tfsdk:"resource_id"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
+}
@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspaces_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/workspaces"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspaces/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfworkspaces "github.com/hashicorp/terraform-provider-aws/internal/service/workspaces"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccConnectionAliasAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ConnectionAliasAssociation
+	rName := sdkacctest.RandString(8)
+	domain := acctest.RandomDomainName()
+	connectionString := acctest.RandomFQDomainName()
+
+	resourceName := "aws_workspaces_connection_alias_association.test"
+	aliasResourceName := "aws_workspaces_connection_alias.test"
+	directoryResourceName := "aws_workspaces_directory.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, strings.ToLower(workspaces.ServiceID))
+			testAccPreCheckDirectory(ctx, t)
+			acctest.PreCheckDirectoryServiceSimpleDirectory(ctx, t)
+			acctest.PreCheckHasIAMRole(ctx, t, "workspaces_DefaultRole")
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, strings.ToLower(workspaces.ServiceID)),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConnectionAliasAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectionAliasAssociationConfig_basic(rName, domain, connectionString),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConnectionAliasAssociationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "alias_id", aliasResourceName, names.AttrID),
+					acctest.CheckResourceAttrAccountID(resourceName, "associated_account_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "connection_identifier"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrResourceID, directoryResourceName, names.AttrID),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrTimeouts},
+			},
+		},
+	})
+}
+
+func testAccConnectionAliasAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ConnectionAliasAssociation
+	rName := sdkacctest.RandString(8)
+	domain := acctest.RandomDomainName()
+	connectionString := acctest.RandomFQDomainName()
+
+	resourceName := "aws_workspaces_connection_alias_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, strings.ToLower(workspaces.ServiceID))
+			testAccPreCheckDirectory(ctx, t)
+			acctest.PreCheckDirectoryServiceSimpleDirectory(ctx, t)
+			acctest.PreCheckHasIAMRole(ctx, t, "workspaces_DefaultRole")
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, strings.ToLower(workspaces.ServiceID)),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConnectionAliasAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectionAliasAssociationConfig_basic(rName, domain, connectionString),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectionAliasAssociationExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfworkspaces.ResourceConnectionAliasAssociation, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckConnectionAliasAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_workspaces_connection_alias_association" {
+				continue
+			}
+
+			_, err := tfworkspaces.FindConnectionAliasAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["alias_id"], rs.Primary.Attributes[names.AttrResourceID])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.WorkSpaces, create.ErrActionCheckingDestroyed, tfworkspaces.ResNameConnectionAliasAssociation, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckConnectionAliasAssociationExists(ctx context.Context, n string, v *awstypes.ConnectionAliasAssociation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return create.Error(names.WorkSpaces, create.ErrActionCheckingExistence, tfworkspaces.ResNameConnectionAliasAssociation, n, errors.New("not found"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesClient(ctx)
+
+		output, err := tfworkspaces.FindConnectionAliasAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["alias_id"], rs.Primary.Attributes[names.AttrResourceID])
+
+		if err != nil {
+			return create.Error(names.WorkSpaces, create.ErrActionCheckingExistence, tfworkspaces.ResNameConnectionAliasAssociation, rs.Primary.ID, err)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccConnectionAliasAssociationConfig_basic(rName, domain, connectionString string) string {
+	return acctest.ConfigCompose(
+		testAccDirectoryConfig_Prerequisites(rName, domain),
+		fmt.Sprintf(`
+resource "aws_workspaces_directory" "test" {
+  directory_id = aws_directory_service_directory.main.id
+
+  tags = {
+    Name = "tf-testacc-workspaces-directory-%[1]s"
+  }
+}
+
+resource "aws_workspaces_connection_alias" "test" {
+  connection_string = %[2]q
+}
+
+resource "aws_workspaces_connection_alias_association" "test" {
+  alias_id    = aws_workspaces_connection_alias.test.id
+  resource_id = aws_workspaces_directory.test.id
+}
+`, rName, connectionString))
+}
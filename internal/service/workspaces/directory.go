@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -41,6 +42,27 @@ func ResourceDirectory() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"certificate_based_auth_properties": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"certificate_authority_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						names.AttrStatus: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          types.CertificateBasedAuthStatusEnumDisabled,
+							ValidateDiagFunc: enum.Validate[types.CertificateBasedAuthStatusEnum](),
+						},
+					},
+				},
+			},
 			"customer_user_name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -77,6 +99,32 @@ func ResourceDirectory() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"saml_properties": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"relay_state_parameter_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "RelayState",
+						},
+						names.AttrStatus: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          types.SamlStatusEnumDisabled,
+							ValidateDiagFunc: enum.Validate[types.SamlStatusEnum](),
+						},
+						"user_access_url": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.All(validation.StringLenBetween(8, 200), validation.IsURLWithHTTPorHTTPS),
+						},
+					},
+				},
+			},
 			"self_service_permissions": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -284,6 +332,30 @@ func resourceDirectoryCreate(ctx context.Context, d *schema.ResourceData, meta i
 		log.Printf("[INFO] Modified WorkSpaces Directory (%s) creation properties", directoryID)
 	}
 
+	if v, ok := d.GetOk("saml_properties"); ok {
+		log.Printf("[DEBUG] Modifying WorkSpaces Directory (%s) SAML properties", directoryID)
+		_, err := conn.ModifySamlProperties(ctx, &workspaces.ModifySamlPropertiesInput{
+			ResourceId:     aws.String(directoryID),
+			SamlProperties: ExpandSAMLProperties(v.([]interface{})),
+		})
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting WorkSpaces Directory (%s) SAML properties: %s", directoryID, err)
+		}
+		log.Printf("[INFO] Modified WorkSpaces Directory (%s) SAML properties", directoryID)
+	}
+
+	if v, ok := d.GetOk("certificate_based_auth_properties"); ok {
+		log.Printf("[DEBUG] Modifying WorkSpaces Directory (%s) certificate-based authentication properties", directoryID)
+		_, err := conn.ModifyCertificateBasedAuthProperties(ctx, &workspaces.ModifyCertificateBasedAuthPropertiesInput{
+			ResourceId:                     aws.String(directoryID),
+			CertificateBasedAuthProperties: ExpandCertificateBasedAuthProperties(v.([]interface{})),
+		})
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting WorkSpaces Directory (%s) certificate-based authentication properties: %s", directoryID, err)
+		}
+		log.Printf("[INFO] Modified WorkSpaces Directory (%s) certificate-based authentication properties", directoryID)
+	}
+
 	if v, ok := d.GetOk("ip_group_ids"); ok && v.(*schema.Set).Len() > 0 {
 		ipGroupIds := v.(*schema.Set)
 		log.Printf("[DEBUG] Associating WorkSpaces Directory (%s) with IP Groups %s", directoryID, ipGroupIds.List())
@@ -327,6 +399,14 @@ func resourceDirectoryRead(ctx context.Context, d *schema.ResourceData, meta int
 	d.Set("directory_type", directory.DirectoryType)
 	d.Set(names.AttrAlias, directory.Alias)
 
+	if err := d.Set("certificate_based_auth_properties", FlattenCertificateBasedAuthProperties(directory.CertificateBasedAuthProperties)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting certificate_based_auth_properties: %s", err)
+	}
+
+	if err := d.Set("saml_properties", FlattenSAMLProperties(directory.SamlProperties)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting saml_properties: %s", err)
+	}
+
 	if err := d.Set("self_service_permissions", FlattenSelfServicePermissions(directory.SelfservicePermissions)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting self_service_permissions: %s", err)
 	}
@@ -396,6 +476,34 @@ func resourceDirectoryUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		log.Printf("[INFO] Modified WorkSpaces Directory (%s) creation properties", d.Id())
 	}
 
+	if d.HasChange("saml_properties") {
+		log.Printf("[DEBUG] Modifying WorkSpaces Directory (%s) SAML properties", d.Id())
+		properties := d.Get("saml_properties").([]interface{})
+
+		_, err := conn.ModifySamlProperties(ctx, &workspaces.ModifySamlPropertiesInput{
+			ResourceId:     aws.String(d.Id()),
+			SamlProperties: ExpandSAMLProperties(properties),
+		})
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating WorkSpaces Directory (%s) SAML properties: %s", d.Id(), err)
+		}
+		log.Printf("[INFO] Modified WorkSpaces Directory (%s) SAML properties", d.Id())
+	}
+
+	if d.HasChange("certificate_based_auth_properties") {
+		log.Printf("[DEBUG] Modifying WorkSpaces Directory (%s) certificate-based authentication properties", d.Id())
+		properties := d.Get("certificate_based_auth_properties").([]interface{})
+
+		_, err := conn.ModifyCertificateBasedAuthProperties(ctx, &workspaces.ModifyCertificateBasedAuthPropertiesInput{
+			ResourceId:                     aws.String(d.Id()),
+			CertificateBasedAuthProperties: ExpandCertificateBasedAuthProperties(properties),
+		})
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating WorkSpaces Directory (%s) certificate-based authentication properties: %s", d.Id(), err)
+		}
+		log.Printf("[INFO] Modified WorkSpaces Directory (%s) certificate-based authentication properties", d.Id())
+	}
+
 	if d.HasChange("ip_group_ids") {
 		o, n := d.GetChange("ip_group_ids")
 		old := o.(*schema.Set)
@@ -500,6 +608,46 @@ func ExpandWorkspaceAccessProperties(properties []interface{}) *types.WorkspaceA
 	return result
 }
 
+func ExpandCertificateBasedAuthProperties(properties []interface{}) *types.CertificateBasedAuthProperties {
+	if len(properties) == 0 || properties[0] == nil {
+		return nil
+	}
+
+	p := properties[0].(map[string]interface{})
+
+	result := &types.CertificateBasedAuthProperties{
+		Status: types.CertificateBasedAuthStatusEnum(p[names.AttrStatus].(string)),
+	}
+
+	if p["certificate_authority_arn"].(string) != "" {
+		result.CertificateAuthorityArn = aws.String(p["certificate_authority_arn"].(string))
+	}
+
+	return result
+}
+
+func ExpandSAMLProperties(properties []interface{}) *types.SamlProperties {
+	if len(properties) == 0 || properties[0] == nil {
+		return nil
+	}
+
+	p := properties[0].(map[string]interface{})
+
+	result := &types.SamlProperties{
+		Status: types.SamlStatusEnum(p[names.AttrStatus].(string)),
+	}
+
+	if p["relay_state_parameter_name"].(string) != "" {
+		result.RelayStateParameterName = aws.String(p["relay_state_parameter_name"].(string))
+	}
+
+	if p["user_access_url"].(string) != "" {
+		result.UserAccessUrl = aws.String(p["user_access_url"].(string))
+	}
+
+	return result
+}
+
 func ExpandSelfServicePermissions(permissions []interface{}) *types.SelfservicePermissions {
 	if len(permissions) == 0 || permissions[0] == nil {
 		return nil
@@ -585,6 +733,33 @@ func FlattenWorkspaceAccessProperties(properties *types.WorkspaceAccessPropertie
 	}
 }
 
+func FlattenCertificateBasedAuthProperties(properties *types.CertificateBasedAuthProperties) []interface{} {
+	if properties == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"certificate_authority_arn": aws.ToString(properties.CertificateAuthorityArn),
+			names.AttrStatus:            string(properties.Status),
+		},
+	}
+}
+
+func FlattenSAMLProperties(properties *types.SamlProperties) []interface{} {
+	if properties == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"relay_state_parameter_name": aws.ToString(properties.RelayStateParameterName),
+			names.AttrStatus:             string(properties.Status),
+			"user_access_url":            aws.ToString(properties.UserAccessUrl),
+		},
+	}
+}
+
 func FlattenSelfServicePermissions(permissions *types.SelfservicePermissions) []interface{} {
 	if permissions == nil {
 		return []interface{}{}
@@ -251,6 +251,53 @@ func testAccDirectory_selfServicePermissions(t *testing.T) {
 	})
 }
 
+func testAccDirectory_samlProperties(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.WorkspaceDirectory
+	rName := sdkacctest.RandString(8)
+
+	resourceName := "aws_workspaces_directory.main"
+
+	domain := acctest.RandomDomainName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckDirectory(ctx, t)
+			acctest.PreCheckDirectoryServiceSimpleDirectory(ctx, t)
+			acctest.PreCheckHasIAMRole(ctx, t, "workspaces_DefaultRole")
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, strings.ToLower(workspaces.ServiceID)),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectoryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryConfig_samlProperties(rName, domain, string(types.SamlStatusEnumEnabled)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectoryExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "saml_properties.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "saml_properties.0.relay_state_parameter_name", "RelayState"),
+					resource.TestCheckResourceAttr(resourceName, "saml_properties.0.status", string(types.SamlStatusEnumEnabled)),
+					resource.TestCheckResourceAttr(resourceName, "saml_properties.0.user_access_url", "https://sso.example.com/"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDirectoryConfig_samlProperties(rName, domain, string(types.SamlStatusEnumEnabledWithDirectoryLoginFallback)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectoryExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "saml_properties.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "saml_properties.0.status", string(types.SamlStatusEnumEnabledWithDirectoryLoginFallback)),
+				),
+			},
+		},
+	})
+}
+
 func testAccDirectory_workspaceAccessProperties(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v types.WorkspaceDirectory
@@ -703,6 +750,176 @@ func TestFlattenWorkspaceCreationProperties(t *testing.T) {
 	}
 }
 
+func TestExpandSAMLProperties(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		input    []interface{}
+		expected *types.SamlProperties
+	}{
+		// Empty
+		{
+			input:    []interface{}{},
+			expected: nil,
+		},
+		// Full
+		{
+			input: []interface{}{
+				map[string]interface{}{
+					"relay_state_parameter_name": "RelayState",
+					names.AttrStatus:             "ENABLED",
+					"user_access_url":            "https://sso.example.com/",
+				},
+			},
+			expected: &types.SamlProperties{
+				RelayStateParameterName: aws.String("RelayState"),
+				Status:                  types.SamlStatusEnumEnabled,
+				UserAccessUrl:           aws.String("https://sso.example.com/"),
+			},
+		},
+		// Without User Access URL
+		{
+			input: []interface{}{
+				map[string]interface{}{
+					"relay_state_parameter_name": "RelayState",
+					names.AttrStatus:             "DISABLED",
+					"user_access_url":            "",
+				},
+			},
+			expected: &types.SamlProperties{
+				RelayStateParameterName: aws.String("RelayState"),
+				Status:                  types.SamlStatusEnumDisabled,
+			},
+		},
+	}
+
+	for _, c := range cases {
+		actual := tfworkspaces.ExpandSAMLProperties(c.input)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Fatalf("expected\n\n%#+v\n\ngot\n\n%#+v", c.expected, actual)
+		}
+	}
+}
+
+func TestFlattenSAMLProperties(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		input    *types.SamlProperties
+		expected []interface{}
+	}{
+		// Empty
+		{
+			input:    nil,
+			expected: []interface{}{},
+		},
+		// Full
+		{
+			input: &types.SamlProperties{
+				RelayStateParameterName: aws.String("RelayState"),
+				Status:                  types.SamlStatusEnumEnabled,
+				UserAccessUrl:           aws.String("https://sso.example.com/"),
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					"relay_state_parameter_name": "RelayState",
+					names.AttrStatus:             "ENABLED",
+					"user_access_url":            "https://sso.example.com/",
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		actual := tfworkspaces.FlattenSAMLProperties(c.input)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Fatalf("expected\n\n%#+v\n\ngot\n\n%#+v", c.expected, actual)
+		}
+	}
+}
+
+func TestExpandCertificateBasedAuthProperties(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		input    []interface{}
+		expected *types.CertificateBasedAuthProperties
+	}{
+		// Empty
+		{
+			input:    []interface{}{},
+			expected: nil,
+		},
+		// Full
+		{
+			input: []interface{}{
+				map[string]interface{}{
+					"certificate_authority_arn": "arn:aws:acm-pca:us-east-1:123456789012:certificate-authority/12345678-1234-1234-1234-123456789012", //lintignore:AWSAT003,AWSAT005
+					names.AttrStatus:            "ENABLED",
+				},
+			},
+			expected: &types.CertificateBasedAuthProperties{
+				CertificateAuthorityArn: aws.String("arn:aws:acm-pca:us-east-1:123456789012:certificate-authority/12345678-1234-1234-1234-123456789012"), //lintignore:AWSAT003,AWSAT005
+				Status:                  types.CertificateBasedAuthStatusEnumEnabled,
+			},
+		},
+		// Without Certificate Authority ARN
+		{
+			input: []interface{}{
+				map[string]interface{}{
+					"certificate_authority_arn": "",
+					names.AttrStatus:            "DISABLED",
+				},
+			},
+			expected: &types.CertificateBasedAuthProperties{
+				Status: types.CertificateBasedAuthStatusEnumDisabled,
+			},
+		},
+	}
+
+	for _, c := range cases {
+		actual := tfworkspaces.ExpandCertificateBasedAuthProperties(c.input)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Fatalf("expected\n\n%#+v\n\ngot\n\n%#+v", c.expected, actual)
+		}
+	}
+}
+
+func TestFlattenCertificateBasedAuthProperties(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		input    *types.CertificateBasedAuthProperties
+		expected []interface{}
+	}{
+		// Empty
+		{
+			input:    nil,
+			expected: []interface{}{},
+		},
+		// Full
+		{
+			input: &types.CertificateBasedAuthProperties{
+				CertificateAuthorityArn: aws.String("arn:aws:acm-pca:us-east-1:123456789012:certificate-authority/12345678-1234-1234-1234-123456789012"), //lintignore:AWSAT003,AWSAT005
+				Status:                  types.CertificateBasedAuthStatusEnumEnabled,
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					"certificate_authority_arn": "arn:aws:acm-pca:us-east-1:123456789012:certificate-authority/12345678-1234-1234-1234-123456789012", //lintignore:AWSAT003,AWSAT005
+					names.AttrStatus:            "ENABLED",
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		actual := tfworkspaces.FlattenCertificateBasedAuthProperties(c.input)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Fatalf("expected\n\n%#+v\n\ngot\n\n%#+v", c.expected, actual)
+		}
+	}
+}
+
 func testAccCheckDirectoryDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesClient(ctx)
@@ -914,6 +1131,25 @@ resource "aws_workspaces_directory" "main" {
 `, tagKey1, tagValue1, tagKey2, tagValue2))
 }
 
+func testAccDirectoryConfig_samlProperties(rName, domain, status string) string {
+	return acctest.ConfigCompose(
+		testAccDirectoryConfig_Prerequisites(rName, domain),
+		fmt.Sprintf(`
+resource "aws_workspaces_directory" "main" {
+  directory_id = aws_directory_service_directory.main.id
+
+  saml_properties {
+    status          = %[2]q
+    user_access_url = "https://sso.example.com/"
+  }
+
+  tags = {
+    Name = "tf-testacc-workspaces-directory-%[1]s"
+  }
+}
+`, rName, status))
+}
+
 func testAccDirectoryConfig_workspaceAccessProperties(rName, domain string) string {
 	return acctest.ConfigCompose(
 		testAccDirectoryConfig_Prerequisites(rName, domain),
@@ -27,6 +27,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory: newResourceConnectionAliasAssociation,
+			Name:    "Connection Alias Association",
+		},
 	}
 }
 
@@ -69,6 +73,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  ResourceStandbyWorkspace,
+			TypeName: "aws_workspaces_standby_workspace",
+			Name:     "Standby Workspace",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrID,
+			},
+		},
 		{
 			Factory:  ResourceWorkspace,
 			TypeName: "aws_workspaces_workspace",
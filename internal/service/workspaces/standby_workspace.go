@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspaces
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workspaces"
+	"github.com/aws/aws-sdk-go-v2/service/workspaces/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_workspaces_standby_workspace", name="Standby Workspace")
+// @Tags(identifierAttribute="id")
+func ResourceStandbyWorkspace() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceStandbyWorkspaceCreate,
+		ReadWithoutTimeout:   resourceStandbyWorkspaceRead,
+		UpdateWithoutTimeout: resourceStandbyWorkspaceUpdate,
+		DeleteWithoutTimeout: resourceStandbyWorkspaceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"data_replication": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[types.DataReplication](),
+			},
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"primary_region": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidRegionName,
+			},
+			"primary_workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrState: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrUserName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"volume_encryption_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(WorkspaceAvailableTimeout),
+			Update: schema.DefaultTimeout(WorkspaceUpdatingTimeout),
+			Delete: schema.DefaultTimeout(WorkspaceTerminatedTimeout),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceStandbyWorkspaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesClient(ctx)
+
+	primaryWorkspaceID := d.Get("primary_workspace_id").(string)
+	request := types.StandbyWorkspace{
+		DirectoryId:        aws.String(d.Get("directory_id").(string)),
+		PrimaryWorkspaceId: aws.String(primaryWorkspaceID),
+		Tags:               getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("data_replication"); ok {
+		request.DataReplication = types.DataReplication(v.(string))
+	}
+
+	if v, ok := d.GetOk("volume_encryption_key"); ok {
+		request.VolumeEncryptionKey = aws.String(v.(string))
+	}
+
+	resp, err := conn.CreateStandbyWorkspaces(ctx, &workspaces.CreateStandbyWorkspacesInput{
+		PrimaryRegion:     aws.String(d.Get("primary_region").(string)),
+		StandbyWorkspaces: []types.StandbyWorkspace{request},
+	})
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating WorkSpaces Standby Workspace (%s): %s", primaryWorkspaceID, err)
+	}
+
+	if wsFail := resp.FailedStandbyRequests; len(wsFail) > 0 {
+		return sdkdiag.AppendErrorf(diags, "creating WorkSpaces Standby Workspace (%s): %s: %s", primaryWorkspaceID, aws.ToString(wsFail[0].ErrorCode), aws.ToString(wsFail[0].ErrorMessage))
+	}
+
+	workspaceID := aws.ToString(resp.PendingStandbyRequests[0].WorkspaceId)
+	d.SetId(workspaceID)
+
+	_, err = WaitWorkspaceAvailable(ctx, conn, workspaceID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for WorkSpaces Standby Workspace (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceStandbyWorkspaceRead(ctx, d, meta)...)
+}
+
+func resourceStandbyWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesClient(ctx)
+
+	rawOutput, state, err := StatusWorkspaceState(ctx, conn, d.Id())()
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading WorkSpaces Standby Workspace (%s): %s", d.Id(), err)
+	}
+	if !d.IsNewResource() && state == string(types.WorkspaceStateTerminated) {
+		log.Printf("[WARN] WorkSpaces Standby Workspace (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	workspace, ok := rawOutput.(types.Workspace)
+	if !ok {
+		return sdkdiag.AppendErrorf(diags, "reading WorkSpaces Standby Workspace (%s): not found", d.Id())
+	}
+
+	if v := workspace.DataReplicationSettings; v != nil {
+		d.Set("data_replication", v.DataReplication)
+	}
+	d.Set("directory_id", workspace.DirectoryId)
+	for _, v := range workspace.RelatedWorkspaces {
+		if v.Type == types.StandbyWorkspaceRelationshipTypePrimary {
+			d.Set("primary_region", v.Region)
+			d.Set("primary_workspace_id", v.WorkspaceId)
+			break
+		}
+	}
+	d.Set(names.AttrState, workspace.State)
+	d.Set(names.AttrUserName, workspace.UserName)
+	d.Set("volume_encryption_key", workspace.VolumeEncryptionKey)
+
+	return diags
+}
+
+func resourceStandbyWorkspaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesClient(ctx)
+
+	if d.HasChange("data_replication") {
+		_, err := conn.ModifyWorkspaceProperties(ctx, &workspaces.ModifyWorkspacePropertiesInput{
+			DataReplication: types.DataReplication(d.Get("data_replication").(string)),
+			WorkspaceId:     aws.String(d.Id()),
+		})
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating WorkSpaces Standby Workspace (%s) data replication: %s", d.Id(), err)
+		}
+
+		_, err = WaitWorkspaceUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for WorkSpaces Standby Workspace (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceStandbyWorkspaceRead(ctx, d, meta)...)
+}
+
+func resourceStandbyWorkspaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesClient(ctx)
+
+	log.Printf("[DEBUG] Deleting WorkSpaces Standby Workspace: %s", d.Id())
+	if err := WorkspaceDelete(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
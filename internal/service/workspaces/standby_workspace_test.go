@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspaces_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/workspaces"
+	"github.com/aws/aws-sdk-go-v2/service/workspaces/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkspaces "github.com/hashicorp/terraform-provider-aws/internal/service/workspaces"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccStandbyWorkspace_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.Workspace
+	rName := sdkacctest.RandString(8)
+	domain := acctest.RandomDomainName()
+
+	resourceName := "aws_workspaces_standby_workspace.test"
+	directoryResourceName := "aws_workspaces_directory.test"
+	primaryResourceName := "aws_workspaces_workspace.primary"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+			testAccPreCheckDirectory(ctx, t)
+			acctest.PreCheckDirectoryServiceSimpleDirectory(ctx, t)
+			acctest.PreCheckHasIAMRole(ctx, t, "workspaces_DefaultRole")
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, strings.ToLower(workspaces.ServiceID)),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckStandbyWorkspaceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStandbyWorkspaceConfig_basic(rName, domain, string(types.DataReplicationNoReplication)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStandbyWorkspaceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "data_replication", string(types.DataReplicationNoReplication)),
+					resource.TestCheckResourceAttrPair(resourceName, "directory_id", directoryResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "primary_region", acctest.AlternateRegion()),
+					resource.TestCheckResourceAttrPair(resourceName, "primary_workspace_id", primaryResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, string(types.WorkspaceStateAvailable)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrUserName, "Administrator"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", fmt.Sprintf("tf-testacc-workspaces-standby-workspace-%[1]s", rName)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccStandbyWorkspaceConfig_basic(rName, domain, string(types.DataReplicationPrimaryAsSource)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStandbyWorkspaceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "data_replication", string(types.DataReplicationPrimaryAsSource)),
+				),
+			},
+		},
+	})
+}
+
+func testAccStandbyWorkspace_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.Workspace
+	rName := sdkacctest.RandString(8)
+	domain := acctest.RandomDomainName()
+
+	resourceName := "aws_workspaces_standby_workspace.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+			testAccPreCheckDirectory(ctx, t)
+			acctest.PreCheckDirectoryServiceSimpleDirectory(ctx, t)
+			acctest.PreCheckHasIAMRole(ctx, t, "workspaces_DefaultRole")
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, strings.ToLower(workspaces.ServiceID)),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckStandbyWorkspaceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStandbyWorkspaceConfig_basic(rName, domain, string(types.DataReplicationNoReplication)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStandbyWorkspaceExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfworkspaces.ResourceStandbyWorkspace(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckStandbyWorkspaceDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_workspaces_standby_workspace" {
+				continue
+			}
+
+			_, state, err := tfworkspaces.StatusWorkspaceState(ctx, conn, rs.Primary.ID)()
+
+			if err != nil {
+				return err
+			}
+
+			if state != string(types.WorkspaceStateTerminating) && state != string(types.WorkspaceStateTerminated) {
+				return fmt.Errorf("WorkSpaces Standby Workspace %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckStandbyWorkspaceExists(ctx context.Context, n string, v *types.Workspace) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesClient(ctx)
+
+		output, _, err := tfworkspaces.StatusWorkspaceState(ctx, conn, rs.Primary.ID)()
+
+		if err != nil {
+			return err
+		}
+
+		workspace, ok := output.(types.Workspace)
+		if !ok {
+			return fmt.Errorf("WorkSpaces Standby Workspace %s not found", rs.Primary.ID)
+		}
+
+		*v = workspace
+
+		return nil
+	}
+}
+
+func testAccStandbyWorkspaceConfig_basic(rName, domain, dataReplication string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAlternateRegionProvider(),
+		testAccWorkspaceConfig_Prerequisites(rName, domain),
+		fmt.Sprintf(`
+data "aws_region" "alternate" {
+  provider = "awsalternate"
+}
+
+data "aws_availability_zones" "alternate" {
+  provider = "awsalternate"
+
+  state = "available"
+
+  filter {
+    name   = "opt-in-status"
+    values = ["opt-in-not-required"]
+  }
+}
+
+resource "aws_vpc" "primary" {
+  provider = "awsalternate"
+
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = "tf-testacc-workspaces-standby-workspace-%[1]s"
+  }
+}
+
+resource "aws_subnet" "primary" {
+  count    = 2
+  provider = "awsalternate"
+
+  vpc_id            = aws_vpc.primary.id
+  availability_zone = data.aws_availability_zones.alternate.names[count.index]
+  cidr_block        = cidrsubnet(aws_vpc.primary.cidr_block, 8, count.index)
+
+  tags = {
+    Name = "tf-testacc-workspaces-standby-workspace-%[1]s"
+  }
+}
+
+resource "aws_directory_service_directory" "primary" {
+  provider = "awsalternate"
+
+  size     = "Small"
+  name     = %[2]q
+  password = "#S1ncerely"
+
+  vpc_settings {
+    vpc_id     = aws_vpc.primary.id
+    subnet_ids = aws_subnet.primary[*].id
+  }
+}
+
+resource "aws_workspaces_directory" "primary" {
+  provider = "awsalternate"
+
+  directory_id = aws_directory_service_directory.primary.id
+}
+
+data "aws_workspaces_bundle" "primary" {
+  provider = "awsalternate"
+
+  bundle_id = "wsb-bh8rsxt14" # Value with Windows 10 (English)
+}
+
+resource "aws_workspaces_workspace" "primary" {
+  provider = "awsalternate"
+
+  bundle_id    = data.aws_workspaces_bundle.primary.id
+  directory_id = aws_workspaces_directory.primary.id
+
+  # NOTE: WorkSpaces API doesn't allow creating users in the directory.
+  # However, "Administrator"" user is always present in a bare directory.
+  user_name = "Administrator"
+}
+
+resource "aws_workspaces_standby_workspace" "test" {
+  data_replication     = %[3]q
+  directory_id         = aws_workspaces_directory.test.id
+  primary_region       = data.aws_region.alternate.name
+  primary_workspace_id = aws_workspaces_workspace.primary.id
+
+  tags = {
+    Name = "tf-testacc-workspaces-standby-workspace-%[1]s"
+  }
+}
+`, rName, domain, dataReplication))
+}
@@ -13,10 +13,15 @@ func TestAccWorkSpaces_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]map[string]func(t *testing.T){
+		"ConnectionAliasAssociation": {
+			acctest.CtBasic:      testAccConnectionAliasAssociation_basic,
+			acctest.CtDisappears: testAccConnectionAliasAssociation_disappears,
+		},
 		"Directory": {
 			acctest.CtBasic:               testAccDirectory_basic,
 			acctest.CtDisappears:          testAccDirectory_disappears,
 			"ipGroupIds":                  testAccDirectory_ipGroupIDs,
+			"samlProperties":              testAccDirectory_samlProperties,
 			"selfServicePermissions":      testAccDirectory_selfServicePermissions,
 			"subnetIDs":                   testAccDirectory_subnetIDs,
 			"tags":                        testAccDirectory_tags,
@@ -30,6 +35,10 @@ func TestAccWorkSpaces_serial(t *testing.T) {
 			"multipleDirectories": testAccIPGroup_MultipleDirectories,
 			"tags":                testAccIPGroup_tags,
 		},
+		"StandbyWorkspace": {
+			acctest.CtBasic:      testAccStandbyWorkspace_basic,
+			acctest.CtDisappears: testAccStandbyWorkspace_disappears,
+		},
 		"Workspace": {
 			acctest.CtBasic:          testAccWorkspace_basic,
 			"recreate":               testAccWorkspace_recreate,